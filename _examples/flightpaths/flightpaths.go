@@ -29,7 +29,7 @@ import (
 	"github.com/matthewhartstonge/graph/vertex"
 )
 
-func solveFlightPath(searchStrategy graph.Strategizer) graph.Grapher {
+func solveFlightPath(searchStrategy graph.Strategizer) *graph.Graph {
 	christchurch := vertex.New("Christchurch")
 	auckland := vertex.New("Auckland")
 	wellington := vertex.New("Wellington")
@@ -62,6 +62,19 @@ func solveFlightPath(searchStrategy graph.Strategizer) graph.Grapher {
 	)
 }
 
+// distanceToGoldCoast provides a heuristic estimating the remaining distance, in
+// kilometres, as the crow flies from a city to the Gold Coast.
+func distanceToGoldCoast(city vertex.Vertexer) float64 {
+	distances := map[string]float64{
+		"Christchurch": 2434,
+		"Auckland":     2224,
+		"Wellington":   2437,
+		"Gold Coast":   0,
+	}
+
+	return distances[city.Label()]
+}
+
 func main() {
 	fmt.Println("Depth-First Solution:")
 	dfs := graph.NewDepthFirstSearch()
@@ -74,4 +87,33 @@ func main() {
 	fmt.Println("Lowest-Cost First Solution:")
 	lcfs := graph.NewLowestCostFirstSearch()
 	utils.PrintSolutions(solveFlightPath(lcfs))
+
+	fmt.Println("A* Solution:")
+	aStar := graph.NewAStarSearch(distanceToGoldCoast)
+	utils.PrintSolutions(solveFlightPath(aStar))
+
+	fmt.Println("Greedy Best-First Solution:")
+	greedy := graph.NewGreedyBestFirstSearch(distanceToGoldCoast)
+	utils.PrintSolutions(solveFlightPath(greedy))
+
+	fmt.Println("Iterative Deepening Depth-First Solution:")
+	iddfs := graph.NewIterativeDeepeningSearch()
+	utils.PrintSolutions(solveFlightPath(iddfs))
+
+	fmt.Println("Iterative Deepening A* Solution:")
+	idaStar := graph.NewIterativeDeepeningAStarSearch(distanceToGoldCoast)
+	utils.PrintSolutions(solveFlightPath(idaStar))
+
+	fmt.Println("Beam (Width 2) Solution:")
+	beam := graph.NewBeamSearch(2, nil)
+	utils.PrintSolutions(solveFlightPath(beam))
+
+	fmt.Println("Branch and Bound Solution:")
+	bnb := graph.NewBranchAndBoundSearch(distanceToGoldCoast)
+	utils.PrintSolutions(solveFlightPath(bnb))
+
+	fmt.Println("K-Shortest Paths (k = 5):")
+	flights := solveFlightPath(graph.NewLowestCostFirstSearch())
+	christchurch, goldCoast := flights.V[0], flights.V[3]
+	utils.PrintPaths(graph.KShortestPaths(flights, christchurch, goldCoast, 5))
 }
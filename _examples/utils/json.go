@@ -41,6 +41,7 @@ type JSONGraph struct {
 		V1       string  `json:"v1"`
 		V2       string  `json:"v2"`
 		Cost     float64 `json:"cost"`
+		Capacity float64 `json:"capacity"`
 		Directed bool    `json:"directed"`
 	} `json:"edges"`
 }
@@ -85,6 +86,7 @@ func JSONGraphToVE(metadata *JSONGraph) (V []vertex.Vertexer, E []edge.Edger) {
 		e := edge.New(
 			v1, v2,
 			edge.WithCost(jsonEdge.Cost),
+			edge.WithCapacity(jsonEdge.Capacity),
 		)
 		e.SetDirected(jsonEdge.Directed)
 		edges = append(edges, e)
@@ -19,6 +19,7 @@ package utils
 
 import (
 	// Standard Library Imports
+	"context"
 	"fmt"
 
 	// Internal Imports
@@ -27,20 +28,32 @@ import (
 )
 
 // PrintSolutions given a graph, will solve and print a solution if found.
-// It will continue this until no more solutions can be found.
+// It will continue this until no more solutions can be found, or the search
+// is stopped early.
 func PrintSolutions(grapher graph.Grapher) {
 	solutionCount := 1
-	for {
-		goalPath := grapher.Search()
-		if goalPath == nil {
-			// no solutions to be found.
-			printSolution(goalPath, solutionCount)
-			break
+	for goalPath, err := range grapher.SolutionsContext(context.Background()) {
+		if err != nil {
+			fmt.Printf("Search stopped early: %s\n\n", err)
+			return
 		}
 
 		printSolution(goalPath, solutionCount)
 		solutionCount++
 	}
+
+	// no more solutions to be found.
+	printSolution(nil, solutionCount)
+}
+
+// PrintPaths prints each of the provided paths as a solution.
+func PrintPaths(paths []path.Pather) {
+	for i, goalPath := range paths {
+		printSolution(goalPath, i+1)
+	}
+
+	// no more solutions to be found.
+	printSolution(nil, len(paths)+1)
 }
 
 // printSolution prints out a single solution.
@@ -0,0 +1,241 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// AllPairsShortestPaths provides the lowest cost of travelling between every
+// pair of vertices in a graph, as a distance matrix, from which the lowest
+// cost path between any pair of vertices can be rebuilt.
+type AllPairsShortestPaths struct {
+	// vertices contains the vertices indexing the matrix.
+	vertices []vertex.Vertexer
+	// index contains each vertex's position in the matrix.
+	index map[vertex.Vertexer]int
+	// distance contains the lowest cost of travelling from the vertex at the
+	// row's index to the vertex at the column's index.
+	distance [][]float64
+	// via contains the last edge travelled along the lowest cost path from
+	// the vertex at the row's index to the vertex at the column's index.
+	via [][]edge.Edger
+}
+
+// newAllPairsShortestPaths returns a distance matrix, over the provided
+// vertices, where every vertex can only reach itself.
+func newAllPairsShortestPaths(vertices []vertex.Vertexer) *AllPairsShortestPaths {
+	apsp := &AllPairsShortestPaths{
+		vertices: vertices,
+		index:    make(map[vertex.Vertexer]int, len(vertices)),
+		distance: make([][]float64, len(vertices)),
+		via:      make([][]edge.Edger, len(vertices)),
+	}
+
+	for i, v := range vertices {
+		apsp.index[v] = i
+		apsp.distance[i] = make([]float64, len(vertices))
+		apsp.via[i] = make([]edge.Edger, len(vertices))
+		for j := range vertices {
+			apsp.distance[i][j] = math.Inf(1)
+		}
+		apsp.distance[i][i] = 0
+	}
+
+	return apsp
+}
+
+// FloydWarshall returns the lowest cost paths between every pair of vertices,
+// using the Floyd-Warshall algorithm. It takes time cubic in the number of
+// vertices, regardless of the number of edges, so is best suited to dense
+// graphs. Edges with negative costs are supported, but if the graph contains a
+// negative cost cycle, a NegativeCycleError is returned.
+func FloydWarshall(g *Graph) (*AllPairsShortestPaths, error) {
+	apsp := newAllPairsShortestPaths(g.vertices())
+	for i, v := range apsp.vertices {
+		for _, e := range g.adjacent[v] {
+			j := apsp.index[e.Head()]
+			if e.Cost() < apsp.distance[i][j] {
+				apsp.distance[i][j] = e.Cost()
+				apsp.via[i][j] = e
+			}
+		}
+	}
+
+	// Allow each vertex, in turn, to be travelled through on the way between
+	// every pair of vertices.
+	for k := range apsp.vertices {
+		for i := range apsp.vertices {
+			if math.IsInf(apsp.distance[i][k], 1) {
+				continue
+			}
+
+			for j := range apsp.vertices {
+				if cost := apsp.distance[i][k] + apsp.distance[k][j]; cost < apsp.distance[i][j] {
+					apsp.distance[i][j] = cost
+					apsp.via[i][j] = apsp.via[k][j]
+				}
+			}
+		}
+	}
+
+	// A vertex that can reach itself at a negative cost sits on a negative
+	// cost cycle, which Bellman-Ford can dig out for us.
+	for i, v := range apsp.vertices {
+		if apsp.distance[i][i] < 0 {
+			if _, err := BellmanFord(g, v); err != nil {
+				return nil, err
+			}
+
+			return nil, ErrNegativeCycle
+		}
+	}
+
+	return apsp, nil
+}
+
+// Johnson returns the lowest cost paths between every pair of vertices, using
+// Johnson's algorithm. Edge costs are reweighted using Bellman-Ford, so they
+// are no longer negative, enabling Dijkstra's algorithm to be run from every
+// vertex. It is best suited to sparse graphs. Edges with negative costs are
+// supported, but if the graph contains a negative cost cycle, a
+// NegativeCycleError is returned.
+func Johnson(g *Graph) (*AllPairsShortestPaths, error) {
+	vertices := g.vertices()
+
+	// Find a potential for each vertex, as if reaching every vertex from a
+	// virtual vertex with edges costing nothing to each.
+	potentials := &ShortestPaths{
+		distance: make(map[vertex.Vertexer]float64, len(vertices)),
+		via:      map[vertex.Vertexer]edge.Edger{},
+	}
+	for _, v := range vertices {
+		potentials.distance[v] = 0
+	}
+
+	if v := g.relaxEdges(potentials); v != nil {
+		return nil, NegativeCycleError{Cycle: potentials.cycleFrom(v, len(vertices))}
+	}
+
+	// Reweight each edge by the difference in potential it travels across,
+	// which leaves no edge with a negative cost, while keeping the lowest
+	// cost paths the same.
+	reweighted := Graph{
+		adjacent: make(map[vertex.Vertexer][]edge.Edger, len(g.adjacent)),
+	}
+	for tail, edges := range g.adjacent {
+		for _, e := range edges {
+			reweighted.adjacent[tail] = append(reweighted.adjacent[tail], &reweightedEdge{
+				Edger: e,
+				cost:  e.Cost() + potentials.distance[e.Tail()] - potentials.distance[e.Head()],
+			})
+		}
+	}
+
+	apsp := newAllPairsShortestPaths(vertices)
+	for i, source := range vertices {
+		tree := reweighted.shortestPathTree(source, nil, nil, nil)
+		for v, distance := range tree.distance {
+			j := apsp.index[v]
+			apsp.distance[i][j] = distance - potentials.distance[source] + potentials.distance[v]
+			if via, ok := tree.via[v]; ok {
+				apsp.via[i][j] = via.(*reweightedEdge).Edger
+			}
+		}
+	}
+
+	return apsp, nil
+}
+
+// reweightedEdge provides a view of an edge with a different cost.
+type reweightedEdge struct {
+	edge.Edger
+	cost float64
+}
+
+// Cost returns the reweighted cost of the edge.
+func (r reweightedEdge) Cost() float64 {
+	return r.cost
+}
+
+// Vertices returns the vertices indexing the distance matrix, in index order.
+func (a AllPairsShortestPaths) Vertices() []vertex.Vertexer {
+	return append([]vertex.Vertexer{}, a.vertices...)
+}
+
+// Index returns the position of the vertex within the distance matrix, and
+// whether the vertex is in the matrix at all.
+func (a AllPairsShortestPaths) Index(v vertex.Vertexer) (index int, ok bool) {
+	index, ok = a.index[v]
+	return
+}
+
+// Matrix returns a copy of the distance matrix, indexed in the same order as
+// Vertices. Unreachable pairs of vertices are positive infinity.
+func (a AllPairsShortestPaths) Matrix() [][]float64 {
+	matrix := make([][]float64, len(a.distance))
+	for i, row := range a.distance {
+		matrix[i] = append([]float64{}, row...)
+	}
+
+	return matrix
+}
+
+// Distance returns the lowest cost of travelling between the vertices. If the
+// destination is unreachable, the distance is positive infinity.
+func (a AllPairsShortestPaths) Distance(from, to vertex.Vertexer) float64 {
+	i, ok := a.index[from]
+	if !ok {
+		return math.Inf(1)
+	}
+
+	j, ok := a.index[to]
+	if !ok {
+		return math.Inf(1)
+	}
+
+	return a.distance[i][j]
+}
+
+// PathTo rebuilds the lowest cost path between the vertices. If the
+// destination is unreachable, nil is returned.
+func (a AllPairsShortestPaths) PathTo(from, to vertex.Vertexer) path.Pather {
+	if math.IsInf(a.Distance(from, to), 1) {
+		return nil
+	}
+
+	// Walk back to the start, then replay the edges in the order travelled.
+	i := a.index[from]
+	edges := []edge.Edger{}
+	for v := to; v != from; v = a.via[i][a.index[v]].Tail() {
+		edges = append(edges, a.via[i][a.index[v]])
+	}
+
+	shortest := startPath(from)
+	for k := len(edges) - 1; k >= 0; k-- {
+		shortest.Append(edges[k])
+	}
+
+	return shortest
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"errors"
+	"fmt"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// ErrNegativeCycle is returned when a cycle with a negative total cost is
+// reachable, in which case, paths through the cycle have no lowest cost.
+var ErrNegativeCycle = errors.New("graph: negative cost cycle")
+
+// NegativeCycleError provides the negative cost cycle that stopped the lowest
+// cost paths from being found.
+type NegativeCycleError struct {
+	// Cycle contains a path starting and ending at the same vertex, whose
+	// total cost is negative.
+	Cycle path.Pather
+}
+
+// Error implements error.
+func (e NegativeCycleError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrNegativeCycle, e.Cycle)
+}
+
+// Unwrap enables errors.Is to match against ErrNegativeCycle.
+func (e NegativeCycleError) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// BellmanFord returns the lowest cost paths from the source vertex to every
+// vertex reachable from it, using the Bellman-Ford algorithm, which, unlike
+// Dijkstra, supports edges with negative costs.
+//
+// If a negative cost cycle is reachable from the source, a NegativeCycleError
+// is returned containing the cycle. An undirected edge with a negative cost is
+// itself a negative cost cycle, as it can be travelled back and forth.
+func BellmanFord(g *Graph, source vertex.Vertexer) (*ShortestPaths, error) {
+	tree := &ShortestPaths{
+		source:   source,
+		distance: map[vertex.Vertexer]float64{source: 0},
+		via:      map[vertex.Vertexer]edge.Edger{},
+	}
+
+	if v := g.relaxEdges(tree); v != nil {
+		return nil, NegativeCycleError{Cycle: tree.cycleFrom(v, len(g.vertices()))}
+	}
+
+	return tree, nil
+}
+
+// relaxEdges repeatedly lowers the cost of reaching each vertex in the tree,
+// wherever travelling an edge from a vertex already reached is cheaper. If a
+// negative cost cycle is found, a vertex leading back to the cycle is
+// returned, otherwise nil.
+func (g Graph) relaxEdges(tree *ShortestPaths) vertex.Vertexer {
+	vertices := g.vertices()
+	edges := []edge.Edger{}
+	for _, v := range vertices {
+		edges = append(edges, g.adjacent[v]...)
+	}
+
+	// relax lowers the cost of reaching the edge's head if travelling the
+	// edge is cheaper, returning the edge's head if so.
+	relax := func(e edge.Edger) vertex.Vertexer {
+		tailCost, ok := tree.distance[e.Tail()]
+		if !ok {
+			return nil
+		}
+
+		headCost, ok := tree.distance[e.Head()]
+		if ok && headCost <= tailCost+e.Cost() {
+			return nil
+		}
+
+		tree.distance[e.Head()] = tailCost + e.Cost()
+		tree.via[e.Head()] = e
+		return e.Head()
+	}
+
+	// A lowest cost path travels at most one fewer edges than there are
+	// vertices, so that many rounds of relaxation settles every path. An
+	// extra round is allowed for when every vertex starts as a source, as if
+	// reached from one more, virtual, vertex.
+	for round := 0; round < len(vertices); round++ {
+		relaxed := false
+		for _, e := range edges {
+			if relax(e) != nil {
+				relaxed = true
+			}
+		}
+
+		if !relaxed {
+			return nil
+		}
+	}
+
+	// If any edge can still be relaxed, it leads to, or sits on, a negative
+	// cost cycle.
+	for _, e := range edges {
+		if v := relax(e); v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// cycleFrom finds the cycle along the edges leading back from the vertex.
+// Walking back as many edges as there are vertices guarantees arriving on the
+// cycle itself.
+func (s ShortestPaths) cycleFrom(v vertex.Vertexer, numVertices int) path.Pather {
+	for i := 0; i < numVertices; i++ {
+		v = s.via[v].Tail()
+	}
+
+	edges := []edge.Edger{}
+	for u := v; len(edges) == 0 || u != v; u = s.via[u].Tail() {
+		edges = append(edges, s.via[u])
+	}
+
+	cycle := startPath(v)
+	for i := len(edges) - 1; i >= 0; i-- {
+		cycle.Append(edges[i])
+	}
+
+	return cycle
+}
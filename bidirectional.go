@@ -0,0 +1,224 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// BidirectionalBreadthFirstSearch finds a path travelling the fewest edges
+// between two vertices, by searching forwards from the start and backwards
+// from the goal at the same time until the two searches meet. If no path
+// exists, nil is returned.
+func (g *Graph) BidirectionalBreadthFirstSearch(from, to vertex.Vertexer) path.Pather {
+	return g.bidirectionalSearch(from, to, func(edge.Edger) float64 {
+		return 1
+	})
+}
+
+// BidirectionalLowestCostSearch finds a lowest cost path between two vertices,
+// by searching forwards from the start and backwards from the goal at the same
+// time until the two searches meet. Edge costs must not be negative. If no
+// path exists, nil is returned.
+func (g *Graph) BidirectionalLowestCostSearch(from, to vertex.Vertexer) path.Pather {
+	return g.bidirectionalSearch(from, to, func(e edge.Edger) float64 {
+		return e.Cost()
+	})
+}
+
+// bidirectionalSearch runs a Dijkstra search from each end, using the provided
+// weight to order each frontier.
+func (g *Graph) bidirectionalSearch(
+	from, to vertex.Vertexer,
+	weight func(edge.Edger) float64,
+) path.Pather {
+	forward := newHalfSearch(from, func(v vertex.Vertexer) []edge.Edger {
+		return g.adjacent[v]
+	}, edge.Edger.Head)
+	backward := newHalfSearch(to, g.incoming, edge.Edger.Tail)
+
+	// best contains the cost of the cheapest path found so far, through the
+	// meeting vertex.
+	best := math.Inf(1)
+	var meeting vertex.Vertexer
+	if from == to {
+		best, meeting = 0, from
+	}
+
+	for forward.queue.Len() > 0 && backward.queue.Len() > 0 {
+		// Once the closest vertices on each frontier can't be joined any
+		// cheaper than the best path found, no cheaper path can exist.
+		if forward.peek()+backward.peek() >= best {
+			break
+		}
+
+		// Expand whichever side has the closer frontier.
+		this, other := forward, backward
+		if backward.peek() < forward.peek() {
+			this, other = backward, forward
+		}
+
+		current := heap.Pop(this.queue).(*queuedVertex)
+		if this.settled[current.vertex] {
+			continue
+		}
+		this.settled[current.vertex] = true
+
+		for _, e := range this.edges(current.vertex) {
+			next := this.across(e)
+			cost := this.cost[current.vertex] + weight(e)
+			if known, ok := this.cost[next]; ok && known <= cost {
+				continue
+			}
+
+			this.cost[next] = cost
+			this.via[next] = e
+			heap.Push(this.queue, &queuedVertex{vertex: next, priority: cost})
+
+			// If the other side has already reached this vertex, the two
+			// searches have met.
+			if otherCost, ok := other.cost[next]; ok && cost+otherCost < best {
+				best, meeting = cost+otherCost, next
+			}
+		}
+	}
+
+	if meeting == nil {
+		return nil
+	}
+
+	// Stitch the two halves together, walking the forward half back to the
+	// start, then the backward half on to the goal.
+	head := []edge.Edger{}
+	for v := meeting; v != from; v = forward.via[v].Tail() {
+		head = append(head, forward.via[v])
+	}
+
+	goalPath := startPath(from)
+	for i := len(head) - 1; i >= 0; i-- {
+		goalPath.Append(head[i])
+	}
+	for v := meeting; v != to; v = backward.via[v].Head() {
+		goalPath.Append(backward.via[v])
+	}
+
+	return goalPath
+}
+
+// incoming returns the edges leading into the vertex, found by way of the
+// vertex's parents.
+func (g Graph) incoming(v vertex.Vertexer) []edge.Edger {
+	edges := []edge.Edger{}
+	for _, parent := range v.Parents() {
+		for _, e := range g.adjacent[parent] {
+			if e.Head() == v {
+				edges = append(edges, e)
+			}
+		}
+	}
+
+	return edges
+}
+
+// newHalfSearch returns one side of a bidirectional search.
+func newHalfSearch(
+	start vertex.Vertexer,
+	edges func(vertex.Vertexer) []edge.Edger,
+	across func(edge.Edger) vertex.Vertexer,
+) *halfSearch {
+	vQueue := &vertexQueue{}
+	heap.Init(vQueue)
+	heap.Push(vQueue, &queuedVertex{vertex: start, priority: 0})
+
+	return &halfSearch{
+		edges:   edges,
+		across:  across,
+		queue:   vQueue,
+		cost:    map[vertex.Vertexer]float64{start: 0},
+		via:     map[vertex.Vertexer]edge.Edger{},
+		settled: map[vertex.Vertexer]bool{},
+	}
+}
+
+// halfSearch provides the state for one side of a bidirectional search.
+type halfSearch struct {
+	// edges returns the edges to travel from a vertex in this direction.
+	edges func(vertex.Vertexer) []edge.Edger
+	// across returns the vertex reached by travelling an edge in this
+	// direction.
+	across func(edge.Edger) vertex.Vertexer
+
+	queue *vertexQueue
+	// cost contains the cheapest known cost of reaching each vertex.
+	cost map[vertex.Vertexer]float64
+	// via contains the edge each vertex was most cheaply reached by.
+	via map[vertex.Vertexer]edge.Edger
+	// settled contains the vertices whose cheapest cost is final.
+	settled map[vertex.Vertexer]bool
+}
+
+// peek returns the priority of the closest vertex in the frontier.
+func (h halfSearch) peek() float64 {
+	return (*h.queue)[0].priority
+}
+
+// queuedVertex binds a vertex to its priority within a vertexQueue.
+type queuedVertex struct {
+	vertex   vertex.Vertexer
+	priority float64
+}
+
+// A vertexQueue implements heap.Interface and provides a priority queue of
+// vertices, ordered by lowest priority first.
+type vertexQueue []*queuedVertex
+
+// Len implements sort.Interface.
+func (vq vertexQueue) Len() int { return len(vq) }
+
+// Less implements sort.Interface.
+func (vq vertexQueue) Less(i, j int) bool {
+	return vq[i].priority < vq[j].priority
+}
+
+// Swap implements sort.Interface.
+func (vq vertexQueue) Swap(i, j int) {
+	vq[i], vq[j] = vq[j], vq[i]
+}
+
+// Push implements heap.Interface.
+func (vq *vertexQueue) Push(x interface{}) {
+	item := x.(*queuedVertex)
+	*vq = append(*vq, item)
+}
+
+// Pop implements heap.Interface.
+func (vq *vertexQueue) Pop() interface{} {
+	old := *vq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*vq = old[0 : n-1]
+	return item
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// ConnectedComponents returns the connected components of the graph, that is,
+// the largest groups of vertices where every vertex can be reached from every
+// other vertex in the group, when every edge is treated as undirected. Every
+// vertex belongs to exactly one component.
+func ConnectedComponents(g *Graph) [][]vertex.Vertexer {
+	return g.connectivity().components
+}
+
+// Bridges returns the edges whose removal would disconnect the vertices they
+// link, when every edge is treated as undirected.
+func Bridges(g *Graph) []edge.Edger {
+	return g.connectivity().bridges
+}
+
+// ArticulationPoints returns the vertices whose removal would disconnect the
+// component they belong to, when every edge is treated as undirected.
+func ArticulationPoints(g *Graph) []vertex.Vertexer {
+	return g.connectivity().articulationPoints
+}
+
+// BiconnectedComponents returns the biconnected components of the graph, when
+// every edge is treated as undirected, as the edges making up each component.
+// Within a biconnected component, no single vertex can be removed to
+// disconnect the rest. Every edge belongs to exactly one component, other than
+// edges looping back to the vertex they leave, which belong to none.
+func BiconnectedComponents(g *Graph) [][]edge.Edger {
+	return g.connectivity().biconnectedComponents
+}
+
+// incidence provides an edge leading out of a vertex, when every edge is
+// treated as undirected, along with the vertex at the other end.
+type incidence struct {
+	edge  edge.Edger
+	other vertex.Vertexer
+}
+
+// incidences returns the edges leading out of each vertex, when every edge is
+// treated as undirected.
+func (g Graph) incidences() map[vertex.Vertexer][]incidence {
+	incidences := map[vertex.Vertexer][]incidence{}
+	for _, e := range g.E {
+		incidences[e.Tail()] = append(incidences[e.Tail()], incidence{edge: e, other: e.Head()})
+		if e.Tail() != e.Head() {
+			incidences[e.Head()] = append(incidences[e.Head()], incidence{edge: e, other: e.Tail()})
+		}
+	}
+
+	return incidences
+}
+
+// connectivity provides the results of exploring an undirected graph
+// depth-first.
+type connectivity struct {
+	components            [][]vertex.Vertexer
+	bridges               []edge.Edger
+	articulationPoints    []vertex.Vertexer
+	biconnectedComponents [][]edge.Edger
+}
+
+// connectivity explores the graph depth-first, treating every edge as
+// undirected, to find its components, bridges and articulation points using
+// Tarjan's algorithm.
+func (g Graph) connectivity() connectivity {
+	incidences := g.incidences()
+
+	result := connectivity{
+		components:            [][]vertex.Vertexer{},
+		bridges:               []edge.Edger{},
+		articulationPoints:    []vertex.Vertexer{},
+		biconnectedComponents: [][]edge.Edger{},
+	}
+
+	session := NewSession()
+	// lowest contains the earliest discovered vertex each vertex's subtree
+	// can reach by way of a single edge back up the tree.
+	lowest := map[vertex.Vertexer]int{}
+	// edges contains the edges travelled that are yet to be assigned to a
+	// biconnected component.
+	edges := []edge.Edger{}
+	component := []vertex.Vertexer{}
+
+	var explore func(v vertex.Vertexer, via edge.Edger)
+	explore = func(v vertex.Vertexer, via edge.Edger) {
+		discovered := session.Discover(v)
+		lowest[v] = discovered
+		component = append(component, v)
+
+		children := 0
+		articulation := false
+		for _, inc := range incidences[v] {
+			// Don't head straight back along the edge we arrived by.
+			if inc.edge == via || inc.other == v {
+				continue
+			}
+
+			if session.Visited(inc.other) {
+				// An edge back up the tree.
+				otherDiscovered, _ := session.Discovered(inc.other)
+				if otherDiscovered < discovered {
+					edges = append(edges, inc.edge)
+					lowest[v] = min(lowest[v], otherDiscovered)
+				}
+				continue
+			}
+
+			children++
+			edges = append(edges, inc.edge)
+			explore(inc.other, inc.edge)
+			lowest[v] = min(lowest[v], lowest[inc.other])
+
+			if lowest[inc.other] > discovered {
+				// Nothing below the edge can reach back around it.
+				result.bridges = append(result.bridges, inc.edge)
+			}
+
+			if lowest[inc.other] >= discovered {
+				// Nothing below the vertex can reach above it, so the edges
+				// travelled since form a biconnected component.
+				if via != nil {
+					articulation = true
+				}
+
+				i := len(edges) - 1
+				for edges[i] != inc.edge {
+					i--
+				}
+				result.biconnectedComponents = append(
+					result.biconnectedComponents,
+					append([]edge.Edger{}, edges[i:]...),
+				)
+				edges = edges[:i]
+			}
+		}
+		session.Finish(v)
+
+		// The root of the tree only holds its children together if it has
+		// more than one.
+		if articulation || (via == nil && children > 1) {
+			result.articulationPoints = append(result.articulationPoints, v)
+		}
+	}
+
+	for _, v := range g.vertices() {
+		if session.Visited(v) {
+			continue
+		}
+
+		component = []vertex.Vertexer{}
+		explore(v, nil)
+		result.components = append(result.components, component)
+	}
+
+	return result
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// FindCycle returns a cycle within the graph, as a path starting and ending at
+// the same vertex, or nil if the graph is acyclic.
+//
+// Directed edges are only travelled from tail to head. Undirected edges may be
+// travelled in either direction, but never straight back along themselves, so
+// in an undirected graph, a cycle must pass through at least two distinct
+// edges, unless an edge loops back to the vertex it leaves.
+func FindCycle(g *Graph) path.Pather {
+	_, cycle := g.depthFirstOrder()
+	return cycle
+}
+
+// AllSimpleCycles returns every simple cycle within a digraph, that is, every
+// cycle which visits no vertex more than once, using Johnson's algorithm. Each
+// cycle starts and ends at its earliest vertex in the graph's vertex order.
+// Parallel edges give rise to distinct cycles. Each cycle is found in time
+// proportional to the size of the graph.
+//
+// The number of cycles in a graph can grow exponentially with its size, so
+// should be used with care on large, densely connected graphs. ErrUndirected
+// is returned if the graph contains undirected edges.
+func AllSimpleCycles(g *Graph) ([]path.Pather, error) {
+	if !g.digraph {
+		return nil, ErrUndirected
+	}
+
+	vertices := g.vertices()
+	index := make(map[vertex.Vertexer]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	cycles := []path.Pather{}
+	for s := 0; s < len(vertices); s++ {
+		// Cycles through earlier vertices have already been found, so only
+		// search the strongly connected component of the earliest vertex
+		// left that sits on a cycle, as no cycle through it can leave its
+		// component.
+		var component map[vertex.Vertexer]bool
+		s, component = g.leastComponent(vertices, index, s)
+		if component == nil {
+			break
+		}
+
+		start := vertices[s]
+		j := &johnson{
+			graph:   g,
+			start:   start,
+			allowed: func(v vertex.Vertexer) bool { return component[v] },
+			blocked: map[vertex.Vertexer]bool{},
+			blocks:  map[vertex.Vertexer]map[vertex.Vertexer]bool{},
+			trail:   []edge.Edger{},
+		}
+		j.circuit(start)
+		cycles = append(cycles, j.cycles...)
+	}
+
+	return cycles, nil
+}
+
+// leastComponent returns the index of the earliest vertex, from the vertex at
+// index s onwards, that sits on a cycle made up of those vertices, along with
+// its strongly connected component among them. If no such vertex sits on a
+// cycle, -1 and a nil component are returned.
+func (g Graph) leastComponent(vertices []vertex.Vertexer, index map[vertex.Vertexer]int, s int) (int, map[vertex.Vertexer]bool) {
+	edges := []edge.Edger{}
+	for _, v := range vertices[s:] {
+		for _, e := range g.adjacent[v] {
+			if index[e.Head()] >= s {
+				edges = append(edges, e)
+			}
+		}
+	}
+
+	least, leastComponent := -1, []vertex.Vertexer(nil)
+	for _, component := range StronglyConnectedComponents(New(
+		WithVertices(vertices[s:]),
+		WithEdges(edges),
+	)) {
+		// A vertex on its own only sits on a cycle if an edge loops back
+		// to it.
+		if len(component) == 1 && !g.loops(component[0]) {
+			continue
+		}
+
+		for _, v := range component {
+			if least < 0 || index[v] < least {
+				least, leastComponent = index[v], component
+			}
+		}
+	}
+
+	if leastComponent == nil {
+		return -1, nil
+	}
+
+	component := make(map[vertex.Vertexer]bool, len(leastComponent))
+	for _, v := range leastComponent {
+		component[v] = true
+	}
+
+	return least, component
+}
+
+// loops returns true if an edge leads out of the vertex straight back to it.
+func (g Graph) loops(v vertex.Vertexer) bool {
+	for _, e := range g.adjacent[v] {
+		if e.Head() == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// johnson provides the state of Johnson's algorithm while searching for the
+// cycles through a single start vertex.
+type johnson struct {
+	graph *Graph
+	start vertex.Vertexer
+	// allowed returns true if the vertex may be travelled through.
+	allowed func(v vertex.Vertexer) bool
+	// blocked contains the vertices that can't currently lead back to the
+	// start.
+	blocked map[vertex.Vertexer]bool
+	// blocks contains, for each vertex, the blocked vertices that must be
+	// unblocked once the vertex is.
+	blocks map[vertex.Vertexer]map[vertex.Vertexer]bool
+	// trail contains the edges travelled from the start.
+	trail  []edge.Edger
+	cycles []path.Pather
+}
+
+// circuit searches for cycles back to the start, continuing from the vertex.
+// It returns true if any cycle was found.
+func (j *johnson) circuit(v vertex.Vertexer) bool {
+	found := false
+	j.blocked[v] = true
+
+	for _, e := range j.graph.adjacent[v] {
+		w := e.Head()
+		if !j.allowed(w) {
+			continue
+		}
+
+		if w == j.start {
+			cycle := startPath(j.start)
+			for _, travelled := range j.trail {
+				cycle.Append(travelled)
+			}
+			cycle.Append(e)
+
+			j.cycles = append(j.cycles, cycle)
+			found = true
+			continue
+		}
+
+		if !j.blocked[w] {
+			j.trail = append(j.trail, e)
+			if j.circuit(w) {
+				found = true
+			}
+			j.trail = j.trail[:len(j.trail)-1]
+		}
+	}
+
+	if found {
+		j.unblock(v)
+		return true
+	}
+
+	// The vertex can't lead back to the start until one of its neighbours
+	// can.
+	for _, e := range j.graph.adjacent[v] {
+		w := e.Head()
+		if !j.allowed(w) {
+			continue
+		}
+
+		if j.blocks[w] == nil {
+			j.blocks[w] = map[vertex.Vertexer]bool{}
+		}
+		j.blocks[w][v] = true
+	}
+
+	return false
+}
+
+// unblock unblocks the vertex, along with any vertices waiting on it.
+func (j *johnson) unblock(v vertex.Vertexer) {
+	j.blocked[v] = false
+	for w := range j.blocks[v] {
+		delete(j.blocks[v], w)
+		if j.blocked[w] {
+			j.unblock(w)
+		}
+	}
+}
@@ -36,6 +36,11 @@ type Edger interface {
 	// SetCost enables the edge cost to be set.
 	SetCost(cost float64)
 
+	// Capacity returns the most flow the edge is able to carry.
+	Capacity() float64
+	// SetCapacity enables the edge capacity to be set.
+	SetCapacity(capacity float64)
+
 	// Directed returns true if the edge is a directed edge. This informs us
 	// that, if the edge is directed, the edge can only be traversed from from
 	// the tail vertex, to the head vertex. An undirected edge means that the
@@ -69,6 +74,7 @@ type Edger interface {
 func New(tail vertex.Vertexer, head vertex.Vertexer, opts ...Option) Edger {
 	edge := &Edge{
 		cost:     0,
+		capacity: 0,
 		directed: true,
 		label:    "",
 		tail:     tail,
@@ -102,6 +108,15 @@ func WithCost(cost float64) Option {
 	}
 }
 
+// WithCapacity sets the capacity of the edge, that is, the most flow the edge
+// is able to carry through a flow network.
+// By default, an edge has no capacity.
+func WithCapacity(capacity float64) Option {
+	return func(edge Edger) {
+		edge.SetCapacity(capacity)
+	}
+}
+
 // WithLabel sets the label for a given edge.
 // By default, an edge has no label.
 func WithLabel(label string) Option {
@@ -128,6 +143,10 @@ type Edge struct {
 	// or cost/weight constrained solutions.
 	cost float64
 
+	// Capacity provides the most flow the edge can carry when used as part
+	// of a flow network.
+	capacity float64
+
 	// Directed specifies whether the edge is either bi-directional, or
 	// directed, that is Vertex1 -> Vertex2.
 	directed bool
@@ -155,6 +174,16 @@ func (e *Edge) SetCost(cost float64) {
 	e.cost = cost
 }
 
+// Capacity returns the most flow the edge is able to carry.
+func (e Edge) Capacity() float64 {
+	return e.capacity
+}
+
+// SetCapacity sets the most flow the edge is able to carry.
+func (e *Edge) SetCapacity(capacity float64) {
+	e.capacity = capacity
+}
+
 // Directed returns true if the edge is a directed edge.
 func (e Edge) Directed() bool {
 	return e.directed
@@ -234,12 +263,12 @@ func (e Edge) String() string {
 		return e.Label()
 	}
 
-	return e.edgeMeta()
+	return edgeMeta(&e)
 }
 
 // edgeMeta generates meta information about the edge to help understand the
 // part this edge plays within a path.
-func (e Edge) edgeMeta() string {
+func edgeMeta(e Edger) string {
 	cost := ""
 	if e.Cost() > 0 {
 		cost = fmt.Sprintf("(%.1f)", e.Cost())
@@ -258,3 +287,51 @@ func (e Edge) edgeMeta() string {
 		e.Head().Label(),
 	)
 }
+
+// Reverse returns a view of the edge oriented from its head to its tail, for
+// use when an undirected edge is traversed from its head. The view shares the
+// underlying edge's cost, label and directionality, and reversing a view
+// returns the underlying edge.
+func Reverse(edger Edger) Edger {
+	if r, ok := edger.(*reversed); ok {
+		return r.Edger
+	}
+
+	return &reversed{Edger: edger}
+}
+
+// reversed provides an oriented view of an edge, swapping its tail and head.
+type reversed struct {
+	Edger
+}
+
+// Tail returns the head of the underlying edge.
+func (r reversed) Tail() vertex.Vertexer {
+	return r.Edger.Head()
+}
+
+// SetTail sets the head of the underlying edge.
+func (r *reversed) SetTail(tail vertex.Vertexer) {
+	r.Edger.SetHead(tail)
+}
+
+// Head returns the tail of the underlying edge.
+func (r reversed) Head() vertex.Vertexer {
+	return r.Edger.Tail()
+}
+
+// SetHead sets the tail of the underlying edge.
+func (r *reversed) SetHead(head vertex.Vertexer) {
+	r.Edger.SetTail(head)
+}
+
+// String implements Stringer.
+// String returns the edge's label, if set, or meta information about the edge
+// in the direction it has been travelled.
+func (r reversed) String() string {
+	if r.Label() != "" {
+		return r.Label()
+	}
+
+	return edgeMeta(&r)
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+var (
+	// ErrSourceIsSink is returned when asked to find a flow from a vertex to
+	// itself.
+	ErrSourceIsSink = errors.New("graph: flow source and sink are the same vertex")
+	// ErrNegativeCapacity is returned when an edge in a flow network has a
+	// negative capacity.
+	ErrNegativeCapacity = errors.New("graph: edge has a negative capacity")
+)
+
+// flowEpsilon provides the smallest amount of flow worth pushing, so rounding
+// errors don't leave us chasing crumbs.
+const flowEpsilon = 1e-9
+
+// Flow provides a flow through a network, from a source vertex to a sink
+// vertex, where each edge carries no more flow than its capacity.
+type Flow struct {
+	// Value contains the total flow leaving the source and arriving at the
+	// sink.
+	Value float64
+	// Cost contains the total cost of the flow, that is, the flow carried by
+	// each edge multiplied by the edge's cost.
+	Cost float64
+	// Cut contains the edges of a minimum cut, that is, the lowest capacity
+	// set of edges that, if removed, would leave no path from the source to
+	// the sink. Their combined capacity is equal to the value of a maximum
+	// flow.
+	Cut []edge.Edger
+	// SourceSide contains the vertices still reachable from the source
+	// through edges with capacity to spare. The sink side contains the rest.
+	SourceSide []vertex.Vertexer
+
+	// edges contains the edges of the network, in the graph's order.
+	edges []edge.Edger
+	// flows contains the flow carried by each edge, from tail to head.
+	// Undirected edges carry negative flow when carrying flow from head to
+	// tail.
+	flows map[edge.Edger]float64
+}
+
+// MaxFlow returns a maximum flow from the source to the sink, along with a
+// minimum cut separating them, using Dinic's algorithm. Each edge can carry as
+// much flow as its capacity, in the direction of the edge, or in either
+// direction if undirected.
+func MaxFlow(g *Graph, source, sink vertex.Vertexer) (*Flow, error) {
+	network, err := newFlowNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	// Layer the network by the number of edges from the source, then push as
+	// much flow as possible along paths heading strictly deeper, until the
+	// sink can no longer be reached.
+	for network.level() {
+		next := make([]int, len(network.vertices))
+		for {
+			pushed := network.augment(network.source, math.Inf(1), next)
+			if pushed <= flowEpsilon {
+				break
+			}
+		}
+	}
+
+	return network.flow(g), nil
+}
+
+// Of returns the flow carried by the edge, from its tail to its head. An
+// undirected edge carrying flow from its head to its tail carries negative
+// flow.
+func (f Flow) Of(e edge.Edger) float64 {
+	return f.flows[e]
+}
+
+// String implements Stringer.
+// String describes the flow carried by each edge, in the same manner as an
+// edge describes itself, with the flow carried and the edge's capacity.
+func (f Flow) String() string {
+	lines := make([]string, len(f.edges))
+	for i, e := range f.edges {
+		lines[i] = describeFlow(e, f.flows[e])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// describeFlow describes the flow carried by an edge, in the direction the
+// flow travels.
+func describeFlow(e edge.Edger, flow float64) string {
+	carrying := fmt.Sprintf("(%.1f/%.1f)", math.Abs(flow), e.Capacity())
+	if e.Label() != "" {
+		return fmt.Sprintf("%s %s", e.Label(), carrying)
+	}
+
+	tail, head := e.Tail(), e.Head()
+	if flow < 0 {
+		tail, head = head, tail
+	}
+
+	tailDirection := ""
+	if !e.Directed() && flow == 0 {
+		tailDirection = "<"
+	}
+
+	return fmt.Sprintf(
+		"(%s) %s-%s-> (%s)",
+		tail.Label(), tailDirection, carrying, head.Label(),
+	)
+}
+
+// flowArc provides an arc in a residual network, along which flow can be
+// pushed.
+type flowArc struct {
+	// head contains the index of the vertex the arc leads to.
+	head int
+	// residual contains the flow the arc can still carry.
+	residual float64
+	// cost contains the cost of pushing each unit of flow along the arc.
+	cost float64
+	// reverse contains the index, within the head's arcs, of the arc
+	// returning flow pushed along this arc.
+	reverse int
+	// edge contains the edge the arc carries flow along, or nil if the arc
+	// only returns flow.
+	edge edge.Edger
+	// forward records whether the arc travels the edge from tail to head.
+	forward bool
+}
+
+// flowNetwork provides a residual network, tracking how much more flow each
+// edge can carry, along with how much flow can be returned.
+type flowNetwork struct {
+	vertices []vertex.Vertexer
+	index    map[vertex.Vertexer]int
+	source   int
+	sink     int
+	arcs     [][]flowArc
+	// levels contains the number of arcs each vertex is from the source,
+	// or -1 if unreachable.
+	levels []int
+}
+
+// newFlowNetwork returns a residual network carrying no flow, built from the
+// graph's edges.
+func newFlowNetwork(g *Graph, source, sink vertex.Vertexer) (*flowNetwork, error) {
+	if source == sink {
+		return nil, ErrSourceIsSink
+	}
+
+	vertices := g.vertices()
+	network := &flowNetwork{
+		vertices: vertices,
+		index:    make(map[vertex.Vertexer]int, len(vertices)),
+		arcs:     make([][]flowArc, len(vertices)),
+		levels:   make([]int, len(vertices)),
+	}
+	for i, v := range vertices {
+		network.index[v] = i
+	}
+
+	var ok bool
+	if network.source, ok = network.index[source]; !ok {
+		network.source = network.addVertex(source)
+	}
+	if network.sink, ok = network.index[sink]; !ok {
+		network.sink = network.addVertex(sink)
+	}
+
+	for _, e := range g.E {
+		if e.Capacity() < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrNegativeCapacity, e)
+		}
+
+		tail, head := network.index[e.Tail()], network.index[e.Head()]
+		if tail == head {
+			continue
+		}
+
+		network.addArc(tail, head, e, true)
+		if !e.Directed() {
+			network.addArc(head, tail, e, false)
+		}
+	}
+
+	return network, nil
+}
+
+// addVertex adds a vertex unknown to the graph, returning its index.
+func (n *flowNetwork) addVertex(v vertex.Vertexer) int {
+	n.index[v] = len(n.vertices)
+	n.vertices = append(n.vertices, v)
+	n.arcs = append(n.arcs, []flowArc{})
+	n.levels = append(n.levels, -1)
+
+	return n.index[v]
+}
+
+// addArc adds an arc carrying flow along the edge, along with an arc to
+// return the flow.
+func (n *flowNetwork) addArc(tail, head int, e edge.Edger, forward bool) {
+	n.arcs[tail] = append(n.arcs[tail], flowArc{
+		head:     head,
+		residual: e.Capacity(),
+		cost:     e.Cost(),
+		reverse:  len(n.arcs[head]),
+		edge:     e,
+		forward:  forward,
+	})
+	n.arcs[head] = append(n.arcs[head], flowArc{
+		head:     tail,
+		residual: 0,
+		cost:     -e.Cost(),
+		reverse:  len(n.arcs[tail]) - 1,
+	})
+}
+
+// push pushes flow along the arc, leaving it to be returned along the
+// reverse arc.
+func (n *flowNetwork) push(tail, arc int, amount float64) {
+	a := &n.arcs[tail][arc]
+	a.residual -= amount
+	n.arcs[a.head][a.reverse].residual += amount
+}
+
+// level layers the network by the number of arcs with capacity to spare
+// between each vertex and the source, returning true if the sink can be
+// reached.
+func (n *flowNetwork) level() bool {
+	for i := range n.levels {
+		n.levels[i] = -1
+	}
+	n.levels[n.source] = 0
+
+	queue := []int{n.source}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, a := range n.arcs[v] {
+			if a.residual > flowEpsilon && n.levels[a.head] < 0 {
+				n.levels[a.head] = n.levels[v] + 1
+				queue = append(queue, a.head)
+			}
+		}
+	}
+
+	return n.levels[n.sink] >= 0
+}
+
+// augment pushes up to the limit of flow from the vertex to the sink, along
+// arcs heading one level deeper at a time, returning how much was pushed. next
+// contains, for each vertex, the first arc that may still lead to the sink.
+func (n *flowNetwork) augment(v int, limit float64, next []int) float64 {
+	if v == n.sink {
+		return limit
+	}
+
+	for ; next[v] < len(n.arcs[v]); next[v]++ {
+		a := n.arcs[v][next[v]]
+		if a.residual <= flowEpsilon || n.levels[a.head] != n.levels[v]+1 {
+			continue
+		}
+
+		if pushed := n.augment(a.head, math.Min(limit, a.residual), next); pushed > flowEpsilon {
+			n.push(v, next[v], pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
+
+// flow reads the flow carried by each of the graph's edges out of the
+// network, along with the minimum cut left behind.
+func (n *flowNetwork) flow(g *Graph) *Flow {
+	f := &Flow{
+		Cut:        []edge.Edger{},
+		SourceSide: []vertex.Vertexer{},
+		edges:      append([]edge.Edger{}, g.E...),
+		flows:      make(map[edge.Edger]float64, len(g.E)),
+	}
+
+	for v := range n.arcs {
+		for _, a := range n.arcs[v] {
+			if a.edge == nil {
+				continue
+			}
+
+			// Whatever has been pushed along the arc can be returned.
+			pushed := n.arcs[a.head][a.reverse].residual
+			if !a.forward {
+				pushed = -pushed
+			}
+			f.flows[a.edge] += pushed
+			f.Cost += math.Abs(pushed) * a.cost
+
+			if v == n.source {
+				f.Value += n.arcs[a.head][a.reverse].residual
+			}
+			if a.head == n.source {
+				f.Value -= n.arcs[a.head][a.reverse].residual
+			}
+		}
+	}
+
+	// The vertices the source can still push flow to sit on the source side
+	// of a minimum cut.
+	n.level()
+	for i, v := range n.vertices {
+		if n.levels[i] >= 0 {
+			f.SourceSide = append(f.SourceSide, v)
+		}
+	}
+
+	for _, e := range g.E {
+		tailSide := n.levels[n.index[e.Tail()]] >= 0
+		headSide := n.levels[n.index[e.Head()]] >= 0
+		if (tailSide && !headSide) || (!e.Directed() && headSide && !tailSide) {
+			f.Cut = append(f.Cut, e)
+		}
+	}
+
+	return f
+}
@@ -19,7 +19,12 @@ package graph
 
 import (
 	// Standard Library Imports
+	"context"
+	"errors"
 	"fmt"
+	"iter"
+	"math"
+	"time"
 
 	// External Imports
 	log "github.com/sirupsen/logrus"
@@ -30,14 +35,30 @@ import (
 	"github.com/matthewhartstonge/graph/vertex"
 )
 
+// ErrLimitExceeded is returned when a search stops early due to reaching one
+// of the limits placed on the search.
+var ErrLimitExceeded = errors.New("graph: search limit exceeded")
+
 // Grapher is the interface that wraps the graph search algorithms.
 //
 // Search traverses a graph in order to find a solution. If no solution is
 // found, nil will be returned. Search should be implemented in such a way that
 // multiple calls to search can continue to find other solutions from where it
 // left off.
+//
+// SearchContext searches in the same manner as Search, but stops early,
+// returning an error, if the context is done or a search limit is reached.
+//
+// Solutions provides an iterator which repeatedly searches for solutions until
+// no more solutions can be found.
+//
+// SolutionsContext provides an iterator in the same manner as Solutions, but
+// yields the error that stopped the search early, if any.
 type Grapher interface {
 	Search() (goalPath path.Pather)
+	SearchContext(ctx context.Context) (goalPath path.Pather, err error)
+	Solutions() iter.Seq[path.Pather]
+	SolutionsContext(ctx context.Context) iter.Seq2[path.Pather, error]
 }
 
 // New creates a new graph that to solve a graph search.
@@ -50,6 +71,11 @@ func New(options ...Option) *Graph {
 		Frontier:         NewDepthFirstSearch(),
 		StartingVertices: []vertex.Vertexer{},
 		Goal:             nil,
+
+		maxCost: math.Inf(1),
+		stats: SearchStats{
+			SolutionDepth: -1,
+		},
 	}
 
 	for _, option := range options {
@@ -108,6 +134,54 @@ func WithTraceLogging() Option {
 	}
 }
 
+// WithCyclePruning prunes any path that would revisit a vertex already on the
+// path, preventing search from looping forever around a cycle.
+func WithCyclePruning() Option {
+	return func(g *Graph) {
+		g.cyclePruning = true
+	}
+}
+
+// WithMultiplePathPruning prunes any path that leads to a vertex that a path
+// has already been expanded from, so each vertex is expanded at most once per
+// search. Only the first path found to a vertex is kept, so it should be used
+// with a strategy that finds the best path to a vertex first, such as
+// lowest-cost-first, when solution cost matters. Strategies that iteratively
+// deepen forget the vertices expanded each time they start a new iteration, so
+// each vertex is expanded at most once per iteration instead.
+func WithMultiplePathPruning() Option {
+	return func(g *Graph) {
+		g.multiplePathPruning = true
+	}
+}
+
+// WithMaxExpansions limits the number of paths that may be expanded by each
+// call to SearchContext. Once reached, the search returns ErrLimitExceeded
+// without touching the frontier, so a further call can resume the search.
+func WithMaxExpansions(n int) Option {
+	return func(g *Graph) {
+		g.maxExpansions = n
+	}
+}
+
+// WithMaxDepth limits the number of edges a path may travel, not including
+// the starting edge. Deeper paths are pruned, and if no solution is found
+// within the limit, the search returns ErrLimitExceeded rather than nil.
+func WithMaxDepth(depth int) Option {
+	return func(g *Graph) {
+		g.maxDepth = depth
+	}
+}
+
+// WithMaxCost limits the cost a path may accumulate. More costly paths are
+// pruned, and if no solution is found within the limit, the search returns
+// ErrLimitExceeded rather than nil.
+func WithMaxCost(cost float64) Option {
+	return func(g *Graph) {
+		g.maxCost = cost
+	}
+}
+
 // GoalFunc provides the algorithm to check if the provided vertex satisfies
 // the goal.
 type GoalFunc func(vertex vertex.Vertexer) bool
@@ -122,11 +196,41 @@ type Graph struct {
 	// Useful for testing a new algorithm or
 	// understanding the process
 	traceLog bool
+	// cyclePruning prunes paths which revisit a vertex already on the path.
+	cyclePruning bool
+	// multiplePathPruning prunes paths to vertices that have already been
+	// expanded.
+	multiplePathPruning bool
+	// maxExpansions limits the number of paths expanded per search call.
+	// Zero means unlimited.
+	maxExpansions int
+	// maxDepth limits the number of edges a path may travel. Zero means
+	// unlimited.
+	maxDepth int
+	// maxCost limits the cost a path may accumulate.
+	maxCost float64
+	// limited records whether a path has been pruned for exceeding the
+	// search's depth or cost limits.
+	limited bool
+	// bound contains the bound of the frontier's current iteration, when
+	// using a strategy that iteratively deepens.
+	bound float64
+	// stats contains the statistics gathered while searching.
+	stats SearchStats
+	// session contains the traversal state of the search, such as the
+	// vertices that have been expanded when pruning multiple paths.
+	session *Session
 
 	// V contains a set of vertices, also called nodes.
 	V []vertex.Vertexer
-	// E contains a set of edges, also called links.
+	// E contains a set of edges, also called links. E is indexed when the
+	// graph is created, so edges must be supplied to New, as edges changed
+	// after the graph has been created won't be searched.
 	E []edge.Edger
+	// adjacent indexes the edges leading out of each vertex, so expanding a
+	// path only needs to consider the edges of its last vertex. It is built
+	// from E when the graph is preprocessed.
+	adjacent map[vertex.Vertexer][]edge.Edger
 
 	// Frontier provides the paths that have been, or may yet to be expanded.
 	// The way in which the frontier returns paths is known as the search
@@ -152,29 +256,77 @@ func (g *Graph) preprocess() *Graph {
 		}
 	}
 
+	// Index each edge against the vertex it leads out of. Undirected edges
+	// lead out of both vertices, so are also indexed against their head,
+	// oriented in the direction they will be travelled.
+	g.adjacent = make(map[vertex.Vertexer][]edge.Edger, len(g.E))
+	for _, e := range g.E {
+		g.adjacent[e.Tail()] = append(g.adjacent[e.Tail()], e)
+		if !e.Directed() && e.Tail() != e.Head() {
+			g.adjacent[e.Head()] = append(g.adjacent[e.Head()], edge.Reverse(e))
+		}
+	}
+
+	g.session = NewSession()
+
 	// First off, we need to add the starting vertices to the frontier so we
 	// have some starting points to attempt to solve the graph search.
 	for _, startingVertex := range g.StartingVertices {
-		// We add the vertices as a path, but with the tail being null, to
-		// enable detection of the start of a path.
-		g.Frontier.Add(path.New(path.WithEdge(
-			edge.New(
-				nil, startingVertex,
-				edge.WithLabel("start"),
-			),
-		)))
+		g.addPath(startPath(startingVertex))
 	}
 
 	return g
 }
 
+// startPath returns a new path starting from the provided vertex.
+func startPath(start vertex.Vertexer) path.Pather {
+	// We add the vertex as a path, but with the tail being null, to enable
+	// detection of the start of a path.
+	return path.New(path.WithEdge(
+		edge.New(
+			nil, start,
+			edge.WithLabel("start"),
+		),
+	))
+}
+
 // Search implements a generic search algorithm: given a graph, starting
 // vertices and a goal, incrementally explore edges from the start vertices.
+//
+// Search is unable to report why a search stopped early, so when limiting
+// the search, SearchContext should be used instead.
 func (g *Graph) Search() (goalPath path.Pather) {
+	goalPath, _ = g.SearchContext(context.Background())
+	return
+}
+
+// SearchContext implements a generic search algorithm, in the same manner as
+// Search, but stops early if the context is done, or the graph's search limits
+// are reached. The frontier is left intact when stopping early, so the search
+// can be resumed with a further call.
+func (g *Graph) SearchContext(ctx context.Context) (goalPath path.Pather, err error) {
+	start := time.Now()
+	defer func() {
+		g.stats.Elapsed += time.Since(start)
+	}()
+
+	expansions := 0
+
 	// Begin looping over all paths within the frontier so we can
 	// attempt to expand a vertex's neighbours in order to build a path to find
 	// a solution.
 	for {
+		// Before touching the frontier, check we're still allowed to be
+		// searching.
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		if g.maxExpansions > 0 && expansions >= g.maxExpansions {
+			return nil, fmt.Errorf(
+				"%w: expanded %d paths", ErrLimitExceeded, expansions,
+			)
+		}
+
 		// From the frontier pull out the next potential goal path to try and
 		// derive a solution.
 		goalPath = g.Frontier.Next()
@@ -182,43 +334,107 @@ func (g *Graph) Search() (goalPath path.Pather) {
 			// If we have no more paths left in the frontier, we have found no
 			// solution, and as such, need to return home with our tails
 			// between our legs.
-			return
+			if g.limited {
+				// Unless we gave up on paths along the way, in which case,
+				// there may be a solution beyond the limits.
+				err = fmt.Errorf(
+					"%w: no solution found within the depth or cost limit",
+					ErrLimitExceeded,
+				)
+			}
+
+			return nil, err
+		}
+		if deepener, ok := g.Frontier.(Deepener); ok && deepener.Bound() != g.bound {
+			// The strategy has moved on to its next iteration.
+			g.bound = deepener.Bound()
+			g.logTrace(traceDeepen, goalPath)
+
+			if g.multiplePathPruning {
+				// Each iteration replays the paths expanded by the last, so
+				// vertices need to be able to be expanded all over again.
+				g.session = NewSession()
+			}
 		}
 		g.logTrace(traceRemovePath, goalPath)
+		g.stats.Expanded++
+		expansions++
 
 		// Given a potential goal path, we need to get the last vertex along
 		// the path to check to see if it satisfies the goal.
 		headVertex := goalPath.Last().Head()
+		if g.multiplePathPruning {
+			// If a path to this vertex has already been expanded, there's
+			// no need to expand it again.
+			if g.session.Visited(headVertex) {
+				g.stats.Pruned++
+				g.logTrace(tracePrunePath, goalPath)
+				continue
+			}
+			g.session.SetVisited(headVertex, true)
+		}
+
 		if g.Goal(headVertex) {
+			// Strategies that iteratively deepen replay paths from earlier
+			// iterations, so the solution may have already been found.
+			if deepener, ok := g.Frontier.(Deepener); ok && deepener.Revisited(goalPath) {
+				continue
+			}
+
 			// If we manage to find a solution, we will be a good Dobby and
 			// tell our master that we did the good.
-			return goalPath
+			if bounder, ok := g.Frontier.(Bounder); ok {
+				bounder.Solved(goalPath)
+			}
+			g.stats.Solutions++
+			g.stats.SolutionDepth = goalPath.Len() - 1
+			return goalPath, nil
 		}
 
-		// Otherwise, search the known edges for neighbours to the current
-		// vertex.
-		for _, knownEdge := range g.E {
-			// we've found an edge which has the current vertex as the starting
-			// point..
-			if knownEdge.Tail() == headVertex {
-				// if the edge is not labelled, give it something to show the
-				// direction.
-				// knownEdge.SetLabel(generateEdgeLabel(knownEdge))
-
-				// Get a deep copy of the path, so that we don't mung data.
-				potentialGoalPath := goalPath.Copy()
-
-				// Expand the potential goal path with the vertex's new found
-				// neighbour and add the path to the frontier for later
-				// processing.
-				potentialGoalPath.Append(knownEdge)
-				g.Frontier.Add(potentialGoalPath)
-				g.logTrace(traceAddPath, potentialGoalPath)
+		// Otherwise, expand the path along each of the edges leading out of
+		// the current vertex.
+		for _, knownEdge := range g.adjacent[headVertex] {
+			if g.cyclePruning && goalPath.Contains(knownEdge.Head()) {
+				// Travelling the edge would take us back around in a circle.
+				g.stats.Pruned++
+				continue
 			}
+
+			// Get a deep copy of the path, so that we don't mung data.
+			potentialGoalPath := goalPath.Copy()
+
+			// Expand the potential goal path with the vertex's new found
+			// neighbour and add the path to the frontier for later
+			// processing.
+			potentialGoalPath.Append(knownEdge)
+			if g.exceedsLimits(potentialGoalPath) {
+				g.limited = true
+				g.stats.Pruned++
+				g.logTrace(tracePrunePath, potentialGoalPath)
+				continue
+			}
+
+			if !g.addPath(potentialGoalPath) {
+				// The strategy refused the path.
+				g.logTrace(tracePrunePath, potentialGoalPath)
+				continue
+			}
+			g.logTrace(traceAddPath, potentialGoalPath)
 		}
 	}
 }
 
+// exceedsLimits returns true if the path travels deeper, or costs more, than
+// the search allows.
+func (g Graph) exceedsLimits(path path.Pather) bool {
+	// The starting edge doesn't count towards the depth of a path.
+	if g.maxDepth > 0 && path.Len()-1 > g.maxDepth {
+		return true
+	}
+
+	return path.Cost() > g.maxCost
+}
+
 // traceAction provides a specific type for tracing actions performed while
 // solving a graph search.
 type traceAction string
@@ -226,6 +442,8 @@ type traceAction string
 const (
 	traceAddPath    traceAction = "+"
 	traceRemovePath traceAction = "-"
+	tracePrunePath  traceAction = "x"
+	traceDeepen     traceAction = "^"
 )
 
 // traceAction enables tracing the operations happening throughout the
@@ -236,6 +454,9 @@ func (g Graph) logTrace(action traceAction, path path.Pather) {
 		if path.Cost() != 0 {
 			fields["cost"] = path.Cost()
 		}
+		if deepener, ok := g.Frontier.(Deepener); ok {
+			fields["bound"] = deepener.Bound()
+		}
 
 		log.WithFields(fields).Trace(fmt.Sprintf("%s %s", action, path))
 	}
@@ -253,52 +474,65 @@ func (g Graph) PrintInfo() {
 	// Print Links
 	fmt.Println("Lineage:")
 	for _, v := range g.V {
-		for _, v := range g.V {
-			// Reset visited status
-			v.SetVisited(false)
-		}
-
-		fmt.Println(printDescendants(v))
+		fmt.Println(printDescendants(NewSession(), v))
 	}
 
 	fmt.Println("\nAncestors:")
 	for _, v := range g.V {
-		for _, v := range g.V {
-			// Reset visited status
-			v.SetVisited(false)
-		}
-
-		fmt.Println(printHeritage(v))
+		fmt.Println(printHeritage(NewSession(), v))
 	}
 }
 
-func printDescendants(vertex vertex.Vertexer) string {
+func printDescendants(session *Session, vertex vertex.Vertexer) string {
 	line := fmt.Sprintf("(%s)\n", vertex.Label())
-	vertex.SetVisited(true)
+	session.SetVisited(vertex, true)
 
 	for _, child := range vertex.Children() {
-		if child.Visited() {
+		if session.Visited(child) {
 			continue
 		}
 
-		child.SetVisited(true)
-		line = fmt.Sprintf("%s|- ancestor of -> %s", line, printDescendants(child))
+		session.SetVisited(child, true)
+		line = fmt.Sprintf("%s|- ancestor of -> %s", line, printDescendants(session, child))
 	}
 
 	return fmt.Sprintf("%s", line)
 }
 
-func printHeritage(vertex vertex.Vertexer) string {
+func printHeritage(session *Session, vertex vertex.Vertexer) string {
 	line := fmt.Sprintf("(%s)\n", vertex.Label())
-	vertex.SetVisited(true)
+	session.SetVisited(vertex, true)
 
 	for _, parent := range vertex.Parents() {
-		if parent.Visited() {
+		if session.Visited(parent) {
 			continue
 		}
 
-		line = fmt.Sprintf("%s|- descendant of -> %s", line, printHeritage(parent))
+		line = fmt.Sprintf("%s|- descendant of -> %s", line, printHeritage(session, parent))
 	}
 
 	return fmt.Sprintf("%s", line)
 }
+
+// vertices returns the graph's vertices, along with any vertices only known
+// through the graph's edges, in the order they are first encountered.
+func (g Graph) vertices() []vertex.Vertexer {
+	seen := map[vertex.Vertexer]bool{}
+	vertices := []vertex.Vertexer{}
+	add := func(v vertex.Vertexer) {
+		if v != nil && !seen[v] {
+			seen[v] = true
+			vertices = append(vertices, v)
+		}
+	}
+
+	for _, v := range g.V {
+		add(v)
+	}
+	for _, e := range g.E {
+		add(e.Tail())
+		add(e.Head())
+	}
+
+	return vertices
+}
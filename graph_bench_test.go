@@ -0,0 +1,111 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"fmt"
+	"math/rand"
+	"testing"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// BenchmarkSearch measures the cost of searching as the graph grows, first by
+// adding vertices with the same number of edges leading out of each, then by
+// adding edges leading out of each vertex. Each search is breadth-first with
+// multiple-path pruning, so expands every vertex it can reach exactly once.
+//
+// Two costs are reported: ns/edge, the cost of following each edge, that is,
+// of each path taken off the frontier whether or not it is pruned, and
+// ns/vertex, the cost of each vertex expanded. As only the edges leading out
+// of a path's last vertex are considered, ns/edge should not grow with the
+// size of the graph, other than with the length of the paths being copied,
+// while ns/vertex should grow in line with the number of edges leading out of
+// each vertex.
+func BenchmarkSearch(b *testing.B) {
+	for _, edges := range []int{1_000, 10_000, 100_000, 500_000} {
+		b.Run(fmt.Sprintf("edges=%d", edges), func(b *testing.B) {
+			benchmarkSearch(b, edges/4, 4)
+		})
+	}
+
+	for _, degree := range []int{2, 4, 16, 64} {
+		b.Run(fmt.Sprintf("degree=%d", degree), func(b *testing.B) {
+			benchmarkSearch(b, 10_000, degree)
+		})
+	}
+}
+
+// benchmarkSearch searches a graph of n vertices, each with the provided
+// number of edges leading out of it, reporting the cost of each edge followed
+// and each vertex expanded.
+func benchmarkSearch(b *testing.B, n int, degree int) {
+	V, E := benchmarkGraph(n, degree)
+
+	followed, expanded := 0, 0
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		g := New(
+			WithVertices(V),
+			WithEdges(E),
+			WithStartingVertices(V[0]),
+			WithSearchStrategy(NewBreadthFirstSearch()),
+			WithMultiplePathPruning(),
+			WithGoalFunc(func(vertex.Vertexer) bool { return false }),
+		)
+		b.StartTimer()
+
+		g.Search()
+
+		// Every path taken off the frontier followed an edge, other than the
+		// starting path, but only paths to vertices not yet visited were
+		// expanded.
+		followed += g.Stats().Expanded - 1
+		expanded += len(g.session.visited)
+	}
+
+	elapsed := float64(b.Elapsed().Nanoseconds())
+	b.ReportMetric(elapsed/float64(followed), "ns/edge")
+	b.ReportMetric(elapsed/float64(expanded), "ns/vertex")
+}
+
+// benchmarkGraph returns a digraph of n vertices, each with the provided number
+// of edges leading out of it. Each vertex leads on to the next, so every
+// vertex can be reached, with the rest of the edges leading to random
+// vertices.
+func benchmarkGraph(n int, degree int) ([]vertex.Vertexer, []edge.Edger) {
+	random := rand.New(rand.NewSource(1))
+
+	V := make([]vertex.Vertexer, n)
+	for i := range V {
+		V[i] = vertex.New(fmt.Sprintf("v%d", i))
+	}
+
+	E := make([]edge.Edger, 0, n*degree)
+	for i, v := range V {
+		E = append(E, edge.New(v, V[(i+1)%n]))
+		for j := 1; j < degree; j++ {
+			E = append(E, edge.New(v, V[random.Intn(n)]))
+		}
+	}
+
+	return V, E
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"errors"
+	"fmt"
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+var (
+	// ErrInadmissibleHeuristic is returned when a heuristic overestimates the
+	// cost of reaching a goal from a vertex.
+	ErrInadmissibleHeuristic = errors.New("graph: heuristic is not admissible")
+	// ErrInconsistentHeuristic is returned when a heuristic does not satisfy
+	// the triangle inequality across an edge.
+	ErrInconsistentHeuristic = errors.New("graph: heuristic is not consistent")
+)
+
+// Heuristic provides an estimate of the cost of the cheapest path from the
+// provided vertex to a goal. Informed search strategies use the estimate to
+// decide which path in the frontier should be expanded next.
+type Heuristic func(vertex vertex.Vertexer) float64
+
+// orZero returns the heuristic, or if nil, a heuristic that estimates zero for
+// every vertex, leaving informed strategies to rank paths by cost alone.
+func (h Heuristic) orZero() Heuristic {
+	if h == nil {
+		return func(vertex.Vertexer) float64 { return 0 }
+	}
+
+	return h
+}
+
+// Admissible checks that the heuristic never overestimates the true cost of
+// reaching a goal, as defined by the graph's goal function, from every vertex
+// in the graph. Vertices that cannot reach a goal are ignored.
+//
+// The true costs are found by exhaustively searching backwards from every goal
+// vertex, so it is intended for validating heuristics in tests rather than
+// for use while solving.
+func (h Heuristic) Admissible(g *Graph) error {
+	costs := costsToGoal(g)
+	for _, v := range g.vertices() {
+		cost, ok := costs[v]
+		if !ok {
+			continue
+		}
+
+		if estimate := h(v); estimate > cost {
+			return fmt.Errorf(
+				"%w: h(%s) = %g exceeds the cost to goal of %g",
+				ErrInadmissibleHeuristic, v.Label(), estimate, cost,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Consistent checks that the heuristic is consistent, or monotone, that is,
+// for every edge the estimate at the tail is no more than the cost of the edge
+// plus the estimate at the head, and that every goal vertex is estimated at
+// zero. A consistent heuristic is also admissible.
+func (h Heuristic) Consistent(g *Graph) error {
+	for _, e := range g.E {
+		tail, head := e.Tail(), e.Head()
+		if h(tail) > e.Cost()+h(head) {
+			return fmt.Errorf(
+				"%w: h(%s) = %g exceeds %g + h(%s) = %g",
+				ErrInconsistentHeuristic, tail.Label(), h(tail),
+				e.Cost(), head.Label(), h(head),
+			)
+		}
+
+		if !e.Directed() && h(head) > e.Cost()+h(tail) {
+			return fmt.Errorf(
+				"%w: h(%s) = %g exceeds %g + h(%s) = %g",
+				ErrInconsistentHeuristic, head.Label(), h(head),
+				e.Cost(), tail.Label(), h(tail),
+			)
+		}
+	}
+
+	if g.Goal == nil {
+		return nil
+	}
+
+	for _, v := range g.vertices() {
+		if g.Goal(v) && h(v) != 0 {
+			return fmt.Errorf(
+				"%w: goal %s is estimated at %g",
+				ErrInconsistentHeuristic, v.Label(), h(v),
+			)
+		}
+	}
+
+	return nil
+}
+
+// costsToGoal returns the cost of the cheapest path from each vertex to any
+// vertex satisfying the graph's goal. Vertices that cannot reach a goal are
+// not included.
+func costsToGoal(g *Graph) map[vertex.Vertexer]float64 {
+	costs := map[vertex.Vertexer]float64{}
+	if g.Goal == nil {
+		return costs
+	}
+
+	vertices := g.vertices()
+	for _, v := range vertices {
+		if g.Goal(v) {
+			costs[v] = 0
+		}
+	}
+
+	// Work backwards from the goals, settling the cheapest unsettled vertex on
+	// each pass and relaxing the edges that lead into it.
+	settled := map[vertex.Vertexer]bool{}
+	for {
+		var next vertex.Vertexer
+		lowest := math.Inf(1)
+		for _, v := range vertices {
+			if cost, ok := costs[v]; ok && !settled[v] && cost < lowest {
+				next, lowest = v, cost
+			}
+		}
+
+		if next == nil {
+			return costs
+		}
+		settled[next] = true
+
+		for _, e := range g.E {
+			var from vertex.Vertexer
+			switch {
+			case e.Head() == next:
+				from = e.Tail()
+			case !e.Directed() && e.Tail() == next:
+				from = e.Head()
+			default:
+				continue
+			}
+
+			cost, ok := costs[from]
+			if !ok || lowest+e.Cost() < cost {
+				costs[from] = lowest + e.Cost()
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// KShortestPaths returns up to k of the lowest cost loopless paths between two
+// vertices, ordered by cost, using Yen's algorithm. Fewer than k paths are
+// returned if there aren't k distinct loopless paths between the vertices.
+// Edge costs must not be negative.
+func KShortestPaths(g *Graph, from, to vertex.Vertexer, k int) []path.Pather {
+	paths := []path.Pather{}
+	if k <= 0 {
+		return paths
+	}
+
+	shortest := g.cheapestPath(from, to, nil, nil)
+	if shortest == nil {
+		return paths
+	}
+	paths = append(paths, shortest)
+
+	// candidates contains the potential next shortest paths.
+	candidates := []path.Pather{}
+	for len(paths) < k {
+		previous := paths[len(paths)-1].Edges()[1:]
+
+		// Branch off from each vertex along the previous path, in search of a
+		// path that shares the same root, but deviates from there on.
+		for i := range previous {
+			spur := previous[i].Tail()
+			root := previous[:i]
+
+			// Stop any known path sharing this root from being found again,
+			// by removing the edge each took after the root.
+			removedEdges := map[edge.Edger]bool{}
+			for _, known := range paths {
+				knownEdges := known.Edges()[1:]
+				if len(knownEdges) > i && sameEdges(knownEdges[:i], root) {
+					removedEdges[knownEdges[i]] = true
+				}
+			}
+
+			// Stop the spur path from looping back through the root.
+			removedVertices := map[vertex.Vertexer]bool{}
+			for _, e := range root {
+				removedVertices[e.Tail()] = true
+			}
+
+			spurPath := g.cheapestPath(spur, to, removedEdges, removedVertices)
+			if spurPath == nil {
+				continue
+			}
+
+			candidate := startPath(from)
+			for _, e := range root {
+				candidate.Append(e)
+			}
+			for _, e := range spurPath.Edges()[1:] {
+				candidate.Append(e)
+			}
+
+			if !containsPath(candidates, candidate) {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		// Take the cheapest candidate, preferring those found first.
+		cheapest := 0
+		for i, candidate := range candidates {
+			if candidate.Cost() < candidates[cheapest].Cost() {
+				cheapest = i
+			}
+		}
+
+		paths = append(paths, candidates[cheapest])
+		candidates = append(candidates[:cheapest], candidates[cheapest+1:]...)
+	}
+
+	return paths
+}
+
+// cheapestPath returns a lowest cost path between two vertices, without
+// travelling any of the removed edges, or through any of the removed
+// vertices. If no path exists, nil is returned.
+func (g Graph) cheapestPath(
+	from, to vertex.Vertexer,
+	removedEdges map[edge.Edger]bool,
+	removedVertices map[vertex.Vertexer]bool,
+) path.Pather {
+	return g.shortestPathTree(from, to, removedEdges, removedVertices).PathTo(to)
+}
+
+// sameEdges returns true if both lists contain the same edges in the same
+// order.
+func sameEdges(a, b []edge.Edger) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsPath returns true if a path travelling the same edges as the
+// provided path is in the list of paths. The starting edges are ignored, as
+// each path is given its own.
+func containsPath(paths []path.Pather, p path.Pather) bool {
+	for _, known := range paths {
+		if sameEdges(known.Edges()[1:], p.Edges()[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// MinCostFlow returns a maximum flow from the source to the sink, costing the
+// least of all maximum flows, using successive shortest paths. The flow's
+// Cost reports its total cost, where each unit of flow carried by an edge
+// costs the edge's cost.
+//
+// Edges may have negative costs, in which case the cheapest paths from the
+// source are first found using Bellman-Ford. If a negative cost cycle with
+// capacity to carry flow is reachable from the source, a NegativeCycleError
+// is returned containing the cycle. An undirected edge with a negative cost
+// and capacity is itself a negative cost cycle, as flow can be sent back and
+// forth along it.
+func MinCostFlow(g *Graph, source, sink vertex.Vertexer) (*Flow, error) {
+	network, err := newFlowNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	potentials, err := network.potentials(g, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Push flow along the cheapest path with capacity to spare, until the
+	// sink can no longer be reached. Each push can only make the paths left
+	// more costly, so the flow remains the cheapest for its value.
+	for {
+		distances, via := network.cheapestPaths(potentials)
+		if math.IsInf(distances[network.sink], 1) {
+			break
+		}
+
+		// Keep reduced costs from going negative, so Dijkstra can continue
+		// to be used.
+		for v, distance := range distances {
+			if !math.IsInf(distance, 1) {
+				potentials[v] += distance
+			}
+		}
+
+		// Push as much flow as the narrowest arc along the path can carry.
+		pushed := math.Inf(1)
+		for v := network.sink; v != network.source; {
+			a := network.arcs[via[v].tail][via[v].arc]
+			pushed = math.Min(pushed, a.residual)
+			v = via[v].tail
+		}
+		for v := network.sink; v != network.source; {
+			network.push(via[v].tail, via[v].arc, pushed)
+			v = via[v].tail
+		}
+	}
+
+	return network.flow(g), nil
+}
+
+// potentials returns the cost of the cheapest path from the source to each
+// vertex in the network, using only edges with capacity, so that costs reduced
+// by them are never negative. Edges looping back to the vertex they leave never
+// carry flow, so are ignored. Vertices the source can't reach are given no
+// potential, as they can never be reached.
+func (n *flowNetwork) potentials(g *Graph, source vertex.Vertexer) ([]float64, error) {
+	potentials := make([]float64, len(n.vertices))
+
+	negative := false
+	carrying := []edge.Edger{}
+	for _, e := range g.E {
+		if e.Capacity() > flowEpsilon && e.Tail() != e.Head() {
+			carrying = append(carrying, e)
+			negative = negative || e.Cost() < 0
+		}
+	}
+
+	// Without negative costs, every reduced cost starts out non-negative.
+	if !negative {
+		return potentials, nil
+	}
+
+	tree, err := BellmanFord(New(WithVertices(g.V), WithEdges(carrying)), source)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range n.vertices {
+		if tree.Reachable(v) {
+			potentials[i] = tree.Distance(v)
+		}
+	}
+
+	return potentials, nil
+}
+
+// flowVia records the arc a vertex was most cheaply reached by.
+type flowVia struct {
+	tail int
+	arc  int
+}
+
+// cheapestPaths returns the cost of the cheapest path from the source to each
+// vertex through arcs with capacity to spare, using Dijkstra with costs
+// reduced by the potentials, along with the arc each vertex was reached by.
+// Unreachable vertices cost infinity.
+func (n *flowNetwork) cheapestPaths(potentials []float64) ([]float64, []flowVia) {
+	distances := make([]float64, len(n.vertices))
+	for i := range distances {
+		distances[i] = math.Inf(1)
+	}
+	distances[n.source] = 0
+
+	via := make([]flowVia, len(n.vertices))
+	settled := make([]bool, len(n.vertices))
+
+	vQueue := &vertexQueue{}
+	heap.Init(vQueue)
+	heap.Push(vQueue, &queuedVertex{vertex: n.vertices[n.source], priority: 0})
+
+	for vQueue.Len() > 0 {
+		v := n.index[heap.Pop(vQueue).(*queuedVertex).vertex]
+		if settled[v] {
+			continue
+		}
+		settled[v] = true
+
+		for i, a := range n.arcs[v] {
+			if a.residual <= flowEpsilon || settled[a.head] {
+				continue
+			}
+
+			// Rounding errors can leave reduced costs a hair below zero.
+			reduced := math.Max(0, a.cost+potentials[v]-potentials[a.head])
+			if distance := distances[v] + reduced; distance < distances[a.head] {
+				distances[a.head] = distance
+				via[a.head] = flowVia{tail: v, arc: i}
+				heap.Push(vQueue, &queuedVertex{vertex: n.vertices[a.head], priority: distance})
+			}
+		}
+	}
+
+	return distances, via
+}
@@ -21,18 +21,22 @@ package path
 import (
 	// Internal Imports
 	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
 )
 
 const emptyIndex = -1
 
 type Pather interface {
 	Append(edge edge.Edger)
+	Contains(vertex vertex.Vertexer) bool
 	Copy() Pather
 	Cost() float64
 	Current() edge.Edger
+	Edges() []edge.Edger
 	Prev() edge.Edger
 	Next() edge.Edger
 	Last() edge.Edger
+	Len() int
 	Reset()
 }
 
@@ -68,6 +72,17 @@ func (p *Path) Append(pathExtension edge.Edger) {
 	p.path = append(p.path, pathExtension)
 }
 
+// Contains returns true if the vertex is reached by any edge along the path.
+func (p Path) Contains(vertex vertex.Vertexer) bool {
+	for _, arc := range p.path {
+		if arc.Head() == vertex {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (p Path) Cost() float64 {
 	return p.cost
 }
@@ -81,6 +96,11 @@ func (p Path) Copy() Pather {
 	}
 }
 
+// Edges returns a copy of the edges along the path, in the order travelled.
+func (p Path) Edges() []edge.Edger {
+	return append([]edge.Edger{}, p.path...)
+}
+
 func (p Path) Current() edge.Edger {
 	if p.current == 0 || len(p.path) >= p.current {
 		return nil
@@ -109,6 +129,11 @@ func (p Path) Last() edge.Edger {
 	return p.path[pathLen-1]
 }
 
+// Len returns the number of edges along the path.
+func (p Path) Len() int {
+	return len(p.path)
+}
+
 func (p *Path) Prev() edge.Edger {
 	p.current--
 	if p.current <= emptyIndex {
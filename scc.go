@@ -0,0 +1,159 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"strings"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// StronglyConnectedComponents returns the strongly connected components of the
+// graph, that is, the largest groups of vertices where every vertex can reach
+// every other vertex in the group, using Tarjan's algorithm. Every vertex
+// belongs to exactly one component.
+//
+// Components are returned in topological order, so every edge between two
+// components leads from an earlier component to a later one. Undirected edges
+// can be travelled either way, so join their vertices into one component.
+func StronglyConnectedComponents(g *Graph) [][]vertex.Vertexer {
+	session := NewSession()
+	components := [][]vertex.Vertexer{}
+
+	// stack contains the vertices yet to be assigned to a component, and
+	// lowest contains the earliest discovered vertex each vertex can reach
+	// whilst still on the stack.
+	stack := []vertex.Vertexer{}
+	onStack := map[vertex.Vertexer]bool{}
+	lowest := map[vertex.Vertexer]int{}
+
+	var connect func(v vertex.Vertexer)
+	connect = func(v vertex.Vertexer) {
+		lowest[v] = session.Discover(v)
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, e := range g.adjacent[v] {
+			w := e.Head()
+			if !session.Visited(w) {
+				connect(w)
+				lowest[v] = min(lowest[v], lowest[w])
+			} else if onStack[w] {
+				discovered, _ := session.Discovered(w)
+				lowest[v] = min(lowest[v], discovered)
+			}
+		}
+		session.Finish(v)
+
+		// If nothing reachable from the vertex was discovered any earlier,
+		// the vertex is the root of a component, made up of everything
+		// stacked on top of it.
+		if discovered, _ := session.Discovered(v); lowest[v] == discovered {
+			component := []vertex.Vertexer{}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+
+				if w == v {
+					break
+				}
+			}
+
+			// Keep the component's vertices in the order they were
+			// discovered.
+			for i, j := 0, len(component)-1; i < j; i, j = i+1, j-1 {
+				component[i], component[j] = component[j], component[i]
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, v := range g.vertices() {
+		if !session.Visited(v) {
+			connect(v)
+		}
+	}
+
+	// Tarjan's algorithm finds components in reverse topological order.
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+
+	return components
+}
+
+// Condensation returns the condensation of the graph, a new directed acyclic
+// graph where each vertex represents one of the graph's strongly connected
+// components, in the order returned by StronglyConnectedComponents. Each
+// vertex is labelled with the labels of the vertices in its component.
+//
+// Components are linked by a single directed edge wherever any edge links
+// their vertices, costing the least of those edges.
+func Condensation(g *Graph) *Graph {
+	components := StronglyConnectedComponents(g)
+
+	vertices := make([]vertex.Vertexer, len(components))
+	represents := map[vertex.Vertexer]vertex.Vertexer{}
+	for i, component := range components {
+		labels := make([]string, len(component))
+		for j, v := range component {
+			labels[j] = v.Label()
+		}
+
+		vertices[i] = vertex.New("{" + strings.Join(labels, ", ") + "}")
+		for _, v := range component {
+			represents[v] = vertices[i]
+		}
+	}
+
+	// Find the cheapest edge linking each pair of components.
+	type link struct {
+		tail, head vertex.Vertexer
+	}
+	links := []link{}
+	costs := map[link]float64{}
+	for _, e := range g.E {
+		l := link{tail: represents[e.Tail()], head: represents[e.Head()]}
+		if l.tail == l.head {
+			continue
+		}
+
+		cost, ok := costs[l]
+		if !ok {
+			links = append(links, l)
+		}
+		if !ok || e.Cost() < cost {
+			costs[l] = e.Cost()
+		}
+	}
+
+	edges := make([]edge.Edger, len(links))
+	for i, l := range links {
+		edges[i] = edge.New(l.tail, l.head, edge.WithCost(costs[l]))
+	}
+
+	return New(
+		WithVertices(vertices),
+		WithEdges(edges),
+	)
+}
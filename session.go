@@ -0,0 +1,128 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// Colour describes how far a depth-first traversal has progressed through a
+// vertex.
+type Colour int
+
+const (
+	// White marks a vertex that has not yet been discovered.
+	White Colour = iota
+	// Grey marks a vertex that has been discovered, but whose descendants are
+	// still being explored.
+	Grey
+	// Black marks a vertex whose descendants have all been explored.
+	Black
+)
+
+// NewSession returns a new, empty, traversal session.
+func NewSession() *Session {
+	return &Session{
+		visited:    map[vertex.Vertexer]bool{},
+		colour:     map[vertex.Vertexer]Colour{},
+		discovered: map[vertex.Vertexer]int{},
+		finished:   map[vertex.Vertexer]int{},
+		time:       0,
+	}
+}
+
+// Session provides the state of a single traversal over a set of vertices.
+//
+// State is keyed by vertex rather than stored on the vertex itself, so the
+// same vertices can be traversed by any number of searches, reports or graphs
+// at once without trampling over each other. A session itself is not safe for
+// concurrent use.
+type Session struct {
+	// visited contains the vertices that have been visited.
+	visited map[vertex.Vertexer]bool
+	// colour contains the depth-first colouring of each discovered vertex.
+	colour map[vertex.Vertexer]Colour
+	// discovered contains the time at which each vertex was discovered.
+	discovered map[vertex.Vertexer]int
+	// finished contains the time at which each vertex was finished.
+	finished map[vertex.Vertexer]int
+	// time provides a logical clock, ticking on every discovery and finish.
+	time int
+}
+
+// Visited returns true if the vertex has been visited during the session.
+func (s Session) Visited(vertex vertex.Vertexer) bool {
+	return s.visited[vertex]
+}
+
+// SetVisited marks the vertex as being visited, or not, during the session.
+func (s *Session) SetVisited(vertex vertex.Vertexer, visited bool) {
+	if !visited {
+		delete(s.visited, vertex)
+		return
+	}
+
+	s.visited[vertex] = true
+}
+
+// Colour returns the depth-first colour of the vertex. Undiscovered vertices
+// are White.
+func (s Session) Colour(vertex vertex.Vertexer) Colour {
+	return s.colour[vertex]
+}
+
+// Discover marks the vertex as visited and colours it Grey, returning the time
+// at which it was discovered.
+func (s *Session) Discover(vertex vertex.Vertexer) int {
+	s.time++
+	s.visited[vertex] = true
+	s.colour[vertex] = Grey
+	s.discovered[vertex] = s.time
+
+	return s.time
+}
+
+// Finish colours the vertex Black, returning the time at which it was
+// finished.
+func (s *Session) Finish(vertex vertex.Vertexer) int {
+	s.time++
+	s.colour[vertex] = Black
+	s.finished[vertex] = s.time
+
+	return s.time
+}
+
+// Discovered returns the time at which the vertex was discovered, and whether
+// it has been discovered at all.
+func (s Session) Discovered(vertex vertex.Vertexer) (time int, ok bool) {
+	time, ok = s.discovered[vertex]
+	return
+}
+
+// Finished returns the time at which the vertex was finished, and whether it
+// has been finished at all.
+func (s Session) Finished(vertex vertex.Vertexer) (time int, ok bool) {
+	time, ok = s.finished[vertex]
+	return
+}
+
+// Reset clears all state held by the session.
+func (s *Session) Reset() {
+	*s = *NewSession()
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// ShortestPaths provides a shortest path tree, that is, the lowest cost of
+// reaching each vertex from a single source vertex, along with the edge each
+// vertex is reached by, from which the lowest cost path to any reachable
+// vertex can be rebuilt.
+type ShortestPaths struct {
+	// source contains the vertex the paths start from.
+	source vertex.Vertexer
+	// distance contains the lowest cost of reaching each reachable vertex.
+	distance map[vertex.Vertexer]float64
+	// via contains the edge each vertex is reached by along its lowest cost
+	// path. The source is not reached by an edge.
+	via map[vertex.Vertexer]edge.Edger
+}
+
+// Dijkstra returns the lowest cost paths from the source vertex to every
+// vertex reachable from it, using Dijkstra's algorithm. Edge costs must not be
+// negative.
+func Dijkstra(g *Graph, source vertex.Vertexer) *ShortestPaths {
+	return g.shortestPathTree(source, nil, nil, nil)
+}
+
+// Source returns the vertex the paths start from.
+func (s ShortestPaths) Source() vertex.Vertexer {
+	return s.source
+}
+
+// Reachable returns true if there is a path from the source to the vertex.
+func (s ShortestPaths) Reachable(to vertex.Vertexer) bool {
+	_, ok := s.distance[to]
+	return ok
+}
+
+// Distance returns the lowest cost of reaching the vertex from the source. If
+// the vertex is unreachable, the distance is positive infinity.
+func (s ShortestPaths) Distance(to vertex.Vertexer) float64 {
+	if distance, ok := s.distance[to]; ok {
+		return distance
+	}
+
+	return math.Inf(1)
+}
+
+// Via returns the last edge travelled along the lowest cost path to the
+// vertex. If the vertex is the source, or is unreachable, nil is returned.
+func (s ShortestPaths) Via(to vertex.Vertexer) edge.Edger {
+	return s.via[to]
+}
+
+// Predecessor returns the vertex visited immediately before the provided
+// vertex along its lowest cost path. If the vertex is the source, or is
+// unreachable, nil is returned.
+func (s ShortestPaths) Predecessor(to vertex.Vertexer) vertex.Vertexer {
+	if via, ok := s.via[to]; ok {
+		return via.Tail()
+	}
+
+	return nil
+}
+
+// PathTo rebuilds the lowest cost path from the source to the vertex. If the
+// vertex is unreachable, nil is returned.
+func (s ShortestPaths) PathTo(to vertex.Vertexer) path.Pather {
+	if !s.Reachable(to) {
+		return nil
+	}
+
+	// Walk back to the source, then replay the edges in the order travelled.
+	edges := []edge.Edger{}
+	for v := to; v != s.source; v = s.via[v].Tail() {
+		edges = append(edges, s.via[v])
+	}
+
+	shortest := startPath(s.source)
+	for i := len(edges) - 1; i >= 0; i-- {
+		shortest.Append(edges[i])
+	}
+
+	return shortest
+}
+
+// shortestPathTree runs Dijkstra's algorithm from the source, without
+// travelling any of the removed edges, or through any of the removed
+// vertices. If a target is provided, the search stops as soon as the lowest
+// cost path to the target is known.
+func (g Graph) shortestPathTree(
+	source, target vertex.Vertexer,
+	removedEdges map[edge.Edger]bool,
+	removedVertices map[vertex.Vertexer]bool,
+) *ShortestPaths {
+	tree := &ShortestPaths{
+		source:   source,
+		distance: map[vertex.Vertexer]float64{},
+		via:      map[vertex.Vertexer]edge.Edger{},
+	}
+
+	pQueue := &priorityQueue{}
+	heap.Init(pQueue)
+	heap.Push(pQueue, startPath(source))
+
+	for pQueue.Len() > 0 {
+		// The first path to reach a vertex out of the queue is the cheapest
+		// path to it.
+		cheapest := heap.Pop(pQueue).(path.Pather)
+		headVertex := cheapest.Last().Head()
+		if tree.Reachable(headVertex) {
+			continue
+		}
+
+		tree.distance[headVertex] = cheapest.Cost()
+		if headVertex != source {
+			tree.via[headVertex] = cheapest.Last()
+		}
+
+		if headVertex == target {
+			break
+		}
+
+		for _, e := range g.adjacent[headVertex] {
+			if removedEdges[e] || removedVertices[e.Head()] || tree.Reachable(e.Head()) {
+				continue
+			}
+
+			next := cheapest.Copy()
+			next.Append(e)
+			heap.Push(pQueue, next)
+		}
+	}
+
+	return tree
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"context"
+	"iter"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// Solutions returns an iterator over the solutions to the graph search. Each
+// solution is found by resuming the search from where the previous solution
+// left off, so stopping early leaves the remaining solutions to be found by a
+// later search.
+//
+// Solutions is unable to report why the search stopped, so when limiting the
+// search, SolutionsContext should be used instead.
+func (g *Graph) Solutions() iter.Seq[path.Pather] {
+	return func(yield func(path.Pather) bool) {
+		for goalPath, err := range g.SolutionsContext(context.Background()) {
+			if err != nil || !yield(goalPath) {
+				return
+			}
+		}
+	}
+}
+
+// SolutionsContext returns an iterator over the solutions to the graph search,
+// in the same manner as Solutions, but searches using SearchContext. If the
+// search stops early, because the context is done or a search limit is
+// reached, the error is yielded along with a nil path as the final value, so
+// running out of solutions can be told apart from giving up on them.
+func (g *Graph) SolutionsContext(ctx context.Context) iter.Seq2[path.Pather, error] {
+	return func(yield func(path.Pather, error) bool) {
+		for {
+			goalPath, err := g.SearchContext(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if goalPath == nil || !yield(goalPath, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Take collects up to the first n values from the sequence, for example, the
+// first n solutions to a graph search.
+func Take[T any](seq iter.Seq[T], n int) []T {
+	values := make([]T, 0, n)
+	if n <= 0 {
+		return values
+	}
+
+	for value := range seq {
+		values = append(values, value)
+		if len(values) == n {
+			break
+		}
+	}
+
+	return values
+}
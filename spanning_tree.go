@@ -0,0 +1,120 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+	"sort"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// MinimumSpanningTree returns a new graph, over the same vertices, containing
+// the lowest cost set of edges that connects every vertex that the graph
+// connects, along with the total cost of those edges. Every edge is treated as
+// undirected. If the graph is disconnected, a minimum spanning forest is
+// returned, spanning each connected component.
+//
+// MinimumSpanningTree uses Kruskal's algorithm.
+func MinimumSpanningTree(g *Graph) (tree *Graph, cost float64) {
+	return Kruskal(g)
+}
+
+// Kruskal returns a minimum spanning tree, or forest, using Kruskal's
+// algorithm, which adds edges cheapest first, skipping any edge that would
+// link vertices that are already connected.
+func Kruskal(g *Graph) (tree *Graph, cost float64) {
+	edges := append([]edge.Edger{}, g.E...)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Cost() < edges[j].Cost()
+	})
+
+	connected := newUnionFind()
+	chosen := []edge.Edger{}
+	for _, e := range edges {
+		if connected.union(e.Tail(), e.Head()) {
+			chosen = append(chosen, e)
+			cost += e.Cost()
+		}
+	}
+
+	return g.spanning(chosen), cost
+}
+
+// Prim returns a minimum spanning tree, or forest, using Prim's algorithm,
+// which grows a tree from a single vertex, adding the cheapest edge leading
+// out of the tree at each step. A new tree is grown from each vertex not yet
+// connected.
+func Prim(g *Graph) (tree *Graph, cost float64) {
+	incidences := g.incidences()
+	inTree := map[vertex.Vertexer]bool{}
+	// cheapest contains the cheapest known edge linking each vertex to the
+	// tree.
+	cheapest := map[vertex.Vertexer]edge.Edger{}
+	chosen := []edge.Edger{}
+
+	for _, root := range g.vertices() {
+		if inTree[root] {
+			continue
+		}
+
+		vQueue := &vertexQueue{}
+		heap.Init(vQueue)
+		heap.Push(vQueue, &queuedVertex{vertex: root, priority: 0})
+
+		for vQueue.Len() > 0 {
+			v := heap.Pop(vQueue).(*queuedVertex).vertex
+			if inTree[v] {
+				continue
+			}
+
+			inTree[v] = true
+			if e, ok := cheapest[v]; ok {
+				chosen = append(chosen, e)
+				cost += e.Cost()
+			}
+
+			for _, inc := range incidences[v] {
+				if inTree[inc.other] {
+					continue
+				}
+
+				if known, ok := cheapest[inc.other]; ok && known.Cost() <= inc.edge.Cost() {
+					continue
+				}
+
+				cheapest[inc.other] = inc.edge
+				heap.Push(vQueue, &queuedVertex{vertex: inc.other, priority: inc.edge.Cost()})
+			}
+		}
+	}
+
+	return g.spanning(chosen), cost
+}
+
+// spanning returns a new graph over the graph's vertices, containing only the
+// provided edges.
+func (g Graph) spanning(edges []edge.Edger) *Graph {
+	return New(
+		WithVertices(g.vertices()),
+		WithEdges(edges),
+	)
+}
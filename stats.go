@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"fmt"
+	"time"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// SearchStats provides statistics gathered while searching a graph, enabling
+// search strategies to be compared against each other. Statistics accumulate
+// across every call made to search the graph.
+type SearchStats struct {
+	// Expanded contains the number of paths removed from the frontier.
+	Expanded int
+	// Added contains the number of paths accepted by the frontier, including
+	// the paths from the starting vertices, and any paths the frontier
+	// replays itself.
+	Added int
+	// Pruned contains the number of paths discarded by cycle pruning,
+	// multiple-path pruning or search limits, or refused or discarded by the
+	// frontier.
+	Pruned int
+	// PeakFrontier contains the largest number of paths held by the frontier
+	// at any one time.
+	PeakFrontier int
+	// Solutions contains the number of solutions found.
+	Solutions int
+	// SolutionDepth contains the number of edges travelled, not including the
+	// starting edge, by the most recent solution. If no solution has been
+	// found, it is -1.
+	SolutionDepth int
+	// Elapsed contains the wall time spent searching.
+	Elapsed time.Duration
+}
+
+// String implements Stringer.
+func (s SearchStats) String() string {
+	return fmt.Sprintf(
+		"expanded: %d, added: %d, pruned: %d, peak frontier: %d, solutions: %d, solution depth: %d, took: %s",
+		s.Expanded, s.Added, s.Pruned, s.PeakFrontier,
+		s.Solutions, s.SolutionDepth, s.Elapsed,
+	)
+}
+
+// Stats returns the statistics gathered while searching the graph.
+func (g Graph) Stats() SearchStats {
+	stats := g.stats
+	if discarder, ok := g.Frontier.(Discarder); ok {
+		// The strategy keeps count of the paths it takes in and throws away.
+		stats.Added += discarder.Added()
+		stats.Pruned += discarder.Discarded()
+	}
+
+	return stats
+}
+
+// addPath adds a path to the frontier, returning false if the frontier
+// refused the path.
+func (g *Graph) addPath(newPath path.Pather) bool {
+	discarder, counts := g.Frontier.(Discarder)
+
+	discarded := 0
+	if counts {
+		discarded = discarder.Discarded()
+	}
+
+	g.Frontier.Add(newPath)
+	if frontierLen := g.Frontier.Len(); frontierLen > g.stats.PeakFrontier {
+		g.stats.PeakFrontier = frontierLen
+	}
+
+	if counts {
+		return discarder.Discarded() == discarded
+	}
+
+	g.stats.Added++
+	return true
+}
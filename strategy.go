@@ -34,3 +34,43 @@ type Strategizer interface {
 	// are no more paths to expand.
 	Next() path.Pather
 }
+
+// Deepener is implemented by search strategies that iteratively deepen, that
+// is, strategies which replay the paths of earlier iterations in order to
+// reach deeper paths. Search expands replayed paths as normal, but only checks
+// a path against the goal the first time it is returned, so solutions are not
+// found more than once. When pruning multiple paths, Search forgets the
+// vertices it has expanded whenever the bound changes.
+type Deepener interface {
+	Strategizer
+	// Bound returns the limit placed on paths in the current iteration, for
+	// example, the depth or estimated cost a path may reach.
+	Bound() float64
+	// Revisited returns true if the path has been returned in an earlier
+	// iteration.
+	Revisited(path path.Pather) bool
+}
+
+// Discarder is implemented by search strategies that refuse paths added to
+// them, or discard paths they hold, rather than returning every path added.
+// Search gathers its statistics from the strategy, so paths the strategy
+// replays itself count as added, and paths it refuses or discards count as
+// pruned.
+type Discarder interface {
+	Strategizer
+	// Added returns the number of paths the strategy has stored, including
+	// paths it has replayed itself.
+	Added() int
+	// Discarded returns the number of paths the strategy has refused or
+	// discarded.
+	Discarded() int
+}
+
+// Bounder is implemented by search strategies that prune paths using the
+// solutions found so far. Search informs the strategy of every solution it
+// finds before returning it.
+type Bounder interface {
+	Strategizer
+	// Solved informs the strategy that the path satisfies the goal.
+	Solved(path path.Pather)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewAStarSearch returns an A* search strategy, guided by the provided
+// heuristic. A nil heuristic estimates zero for every vertex, in which case,
+// paths are expanded lowest cost first.
+func NewAStarSearch(heuristic Heuristic) *AStar {
+	eQueue := &estimateQueue{}
+	heap.Init(eQueue)
+
+	return &AStar{
+		heuristic: heuristic.orZero(),
+		queue:     eQueue,
+	}
+}
+
+// AStar provides an A* search strategy. It always selects a path from the
+// frontier with the lowest cost plus heuristic estimate of the remaining cost
+// to a goal. Given an admissible heuristic, the first solution found is one of
+// the lowest cost.
+type AStar struct {
+	heuristic Heuristic
+	queue     *estimateQueue
+}
+
+// Len returns the current number of paths stored in the queue.
+func (a AStar) Len() int {
+	return len(*a.queue)
+}
+
+// Add enqueues a path, prioritised by its cost plus the heuristic estimate
+// from the last vertex on the path.
+func (a *AStar) Add(newPath path.Pather) {
+	heap.Push(a.queue, &estimatedPath{
+		path:     newPath,
+		estimate: newPath.Cost() + a.heuristic(newPath.Last().Head()),
+	})
+}
+
+// Next dequeues the path with the lowest estimate from the queue.
+func (a *AStar) Next() (nextPath path.Pather) {
+	if a.Len() > 0 {
+		return heap.Pop(a.queue).(*estimatedPath).path
+	}
+
+	return
+}
+
+var _ Strategizer = &AStar{}
+
+// estimatedPath binds a path to its estimated priority, so the estimate is
+// only computed once when the path is added to the frontier.
+type estimatedPath struct {
+	path     path.Pather
+	estimate float64
+}
+
+// An estimateQueue implements heap.Interface and provides a priority queue
+// ordered by lowest estimate first.
+type estimateQueue []*estimatedPath
+
+// Len implements sort.Interface.
+func (eq estimateQueue) Len() int { return len(eq) }
+
+// Less implements sort.Interface.
+func (eq estimateQueue) Less(i, j int) bool {
+	return eq[i].estimate < eq[j].estimate
+}
+
+// Swap implements sort.Interface.
+func (eq estimateQueue) Swap(i, j int) {
+	eq[i], eq[j] = eq[j], eq[i]
+}
+
+// Push implements heap.Interface.
+func (eq *estimateQueue) Push(x interface{}) {
+	item := x.(*estimatedPath)
+	*eq = append(*eq, item)
+}
+
+// Pop implements heap.Interface.
+func (eq *estimateQueue) Pop() interface{} {
+	old := *eq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	*eq = old[0 : n-1]
+	return item
+}
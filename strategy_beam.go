@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"sort"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewBeamSearch returns a beam search strategy, keeping at most width paths
+// per level. Paths are ranked by the provided heuristic, or by path cost if
+// the heuristic is nil. A beam must keep at least one path to get anywhere, so
+// a width less than one is treated as one.
+func NewBeamSearch(width int, heuristic Heuristic) *Beam {
+	if width < 1 {
+		width = 1
+	}
+
+	return &Beam{
+		width:     width,
+		heuristic: heuristic,
+		level:     []path.Pather{},
+		next:      []*estimatedPath{},
+	}
+}
+
+// Beam provides a beam search strategy. It explores the graph level by level,
+// as with a breadth-first search, but only keeps the best ranked paths of each
+// level, discarding the rest. This bounds the memory used by the frontier, at
+// the cost of no longer guaranteeing a solution will be found.
+type Beam struct {
+	// width contains the number of paths kept per level.
+	width     int
+	heuristic Heuristic
+	// level contains the kept paths of the current level, best first.
+	level []path.Pather
+	// next contains the candidate paths for the next level.
+	next []*estimatedPath
+	// added contains the number of candidate paths stored.
+	added int
+	// discarded contains the number of candidate paths that didn't make
+	// the cut for their level.
+	discarded int
+}
+
+// Len returns the current number of paths stored in the beam, including the
+// candidates for the next level.
+func (b Beam) Len() int {
+	return len(b.level) + len(b.next)
+}
+
+// Add stores a path as a candidate for the next level.
+func (b *Beam) Add(newPath path.Pather) {
+	estimate := newPath.Cost()
+	if b.heuristic != nil {
+		estimate = b.heuristic(newPath.Last().Head())
+	}
+
+	b.next = append(b.next, &estimatedPath{
+		path:     newPath,
+		estimate: estimate,
+	})
+	b.added++
+}
+
+// Next returns the best ranked path remaining in the current level. Once the
+// current level has been exhausted, the best candidates are kept to form the
+// next level.
+func (b *Beam) Next() (nextPath path.Pather) {
+	if len(b.level) == 0 {
+		b.advance()
+	}
+
+	if len(b.level) > 0 {
+		nextPath = b.level[0]
+		b.level = b.level[1:]
+	}
+
+	return
+}
+
+// advance moves on to the next level, keeping only the best ranked
+// candidates.
+func (b *Beam) advance() {
+	sort.SliceStable(b.next, func(i, j int) bool {
+		return b.next[i].estimate < b.next[j].estimate
+	})
+
+	if len(b.next) > b.width {
+		b.discarded += len(b.next) - b.width
+		b.next = b.next[:b.width]
+	}
+
+	for _, candidate := range b.next {
+		b.level = append(b.level, candidate.path)
+	}
+	b.next = []*estimatedPath{}
+}
+
+// Added returns the number of candidate paths stored.
+func (b Beam) Added() int {
+	return b.added
+}
+
+// Discarded returns the number of candidate paths discarded for not being
+// among the best ranked of their level.
+func (b Beam) Discarded() int {
+	return b.discarded
+}
+
+var _ Discarder = &Beam{}
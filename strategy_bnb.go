@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewBranchAndBoundSearch returns a depth-first branch and bound search
+// strategy. If provided, the heuristic is added to a path's cost when
+// comparing it against the bound, otherwise the path's cost is used alone.
+func NewBranchAndBoundSearch(heuristic Heuristic) *BranchAndBound {
+	return &BranchAndBound{
+		stack:     NewDepthFirstSearch(),
+		heuristic: heuristic,
+		bound:     math.Inf(1),
+	}
+}
+
+// BranchAndBound provides a depth-first branch and bound search strategy. It
+// searches depth-first, but remembers the cost of the cheapest solution found
+// so far, pruning any path that can't lead to a cheaper solution. Each
+// solution found is cheaper than the last, and once the frontier has been
+// exhausted, the last solution found is one of the lowest cost, as long as
+// the heuristic, if any, is admissible.
+type BranchAndBound struct {
+	// stack provides the frontier.
+	stack     *DFS
+	heuristic Heuristic
+	// bound contains the cost of the cheapest solution found so far.
+	bound float64
+	// added contains the number of paths pushed on to the stack.
+	added int
+	// discarded contains the number of paths that couldn't lead to a
+	// cheaper solution.
+	discarded int
+}
+
+// Len returns the current number of paths stored in the stack.
+func (b BranchAndBound) Len() int {
+	return b.stack.Len()
+}
+
+// Add pushes a path on to the top of the stack, unless it can't lead to a
+// solution cheaper than the bound.
+func (b *BranchAndBound) Add(newPath path.Pather) {
+	if b.estimate(newPath) >= b.bound {
+		b.discarded++
+		return
+	}
+
+	b.stack.Add(newPath)
+	b.added++
+}
+
+// Next pops the path that is sitting on top of the stack, discarding any
+// paths that can no longer lead to a solution cheaper than the bound.
+func (b *BranchAndBound) Next() path.Pather {
+	for {
+		nextPath := b.stack.Next()
+		if nextPath == nil || b.estimate(nextPath) < b.bound {
+			return nextPath
+		}
+
+		b.discarded++
+	}
+}
+
+// Solved lowers the bound to the cost of the solution, if cheaper.
+func (b *BranchAndBound) Solved(solution path.Pather) {
+	if solution.Cost() < b.bound {
+		b.bound = solution.Cost()
+	}
+}
+
+// Bound returns the cost of the cheapest solution found so far.
+func (b BranchAndBound) Bound() float64 {
+	return b.bound
+}
+
+// estimate returns the path's cost plus the heuristic estimate from the last
+// vertex on the path.
+func (b BranchAndBound) estimate(path path.Pather) float64 {
+	if b.heuristic == nil {
+		return path.Cost()
+	}
+
+	return path.Cost() + b.heuristic(path.Last().Head())
+}
+
+// Added returns the number of paths pushed on to the stack.
+func (b BranchAndBound) Added() int {
+	return b.added
+}
+
+// Discarded returns the number of paths refused, or discarded from the stack,
+// for being unable to lead to a solution cheaper than the bound.
+func (b BranchAndBound) Discarded() int {
+	return b.discarded
+}
+
+var (
+	_ Bounder   = &BranchAndBound{}
+	_ Discarder = &BranchAndBound{}
+)
@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"container/heap"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewGreedyBestFirstSearch returns a greedy best-first search strategy, guided
+// by the provided heuristic. A nil heuristic estimates zero for every vertex,
+// in which case, every path is ranked equally.
+func NewGreedyBestFirstSearch(heuristic Heuristic) *GreedyBFS {
+	eQueue := &estimateQueue{}
+	heap.Init(eQueue)
+
+	return &GreedyBFS{
+		heuristic: heuristic.orZero(),
+		queue:     eQueue,
+	}
+}
+
+// GreedyBFS provides a greedy best-first search strategy. It always selects a
+// path from the frontier whose last vertex has the lowest heuristic estimate,
+// ignoring the cost accumulated along the path. It tends to find a solution
+// quickly, but makes no promise that the solution is the lowest cost.
+type GreedyBFS struct {
+	heuristic Heuristic
+	queue     *estimateQueue
+}
+
+// Len returns the current number of paths stored in the queue.
+func (g GreedyBFS) Len() int {
+	return len(*g.queue)
+}
+
+// Add enqueues a path, prioritised by the heuristic estimate from the last
+// vertex on the path.
+func (g *GreedyBFS) Add(newPath path.Pather) {
+	heap.Push(g.queue, &estimatedPath{
+		path:     newPath,
+		estimate: g.heuristic(newPath.Last().Head()),
+	})
+}
+
+// Next dequeues the path with the lowest estimate from the queue.
+func (g *GreedyBFS) Next() (nextPath path.Pather) {
+	if g.Len() > 0 {
+		return heap.Pop(g.queue).(*estimatedPath).path
+	}
+
+	return
+}
+
+var _ Strategizer = &GreedyBFS{}
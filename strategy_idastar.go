@@ -0,0 +1,163 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"math"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewIterativeDeepeningAStarSearch returns an iterative deepening A* search
+// strategy, guided by the provided heuristic. A nil heuristic estimates zero
+// for every vertex, in which case, the threshold is raised by path cost alone.
+func NewIterativeDeepeningAStarSearch(heuristic Heuristic) *IDAStar {
+	return &IDAStar{
+		heuristic: heuristic.orZero(),
+		stack:     NewDepthFirstSearch(),
+		starts:    []path.Pather{},
+		previous:  math.Inf(-1),
+		threshold: math.Inf(-1),
+		next:      math.Inf(1),
+	}
+}
+
+// IDAStar provides an iterative deepening A* search strategy. It performs a
+// depth-first search that refuses paths whose cost plus heuristic estimate
+// exceeds a threshold, and once exhausted, raises the threshold to the lowest
+// estimate that was refused and starts again. Solutions are found in the same
+// order as A*, but only the current path's siblings and ancestors are stored.
+//
+// Paths are only checked against the goal the first time they fall within the
+// threshold, which relies on the heuristic being consistent. When pruning
+// multiple paths, each vertex is expanded at most once per iteration, along
+// the first path found to it depth-first, which may not be the cheapest, so
+// solutions may no longer be found in order of cost.
+type IDAStar struct {
+	heuristic Heuristic
+	// stack provides the frontier for the current iteration.
+	stack *DFS
+	// starts contains the starting paths, which are replayed at the
+	// beginning of each iteration.
+	starts []path.Pather
+	// previous contains the threshold of the previous iteration.
+	previous float64
+	// threshold contains the highest estimate a path may have in the
+	// current iteration.
+	threshold float64
+	// next contains the lowest estimate refused in the current iteration,
+	// which becomes the threshold for the next iteration.
+	next float64
+	// added contains the number of paths pushed on to the stack.
+	added int
+	// discarded contains the number of paths refused for exceeding the
+	// threshold.
+	discarded int
+}
+
+// Len returns the current number of paths stored in the stack.
+func (i IDAStar) Len() int {
+	return i.stack.Len()
+}
+
+// Add pushes a path on to the top of the stack, unless the path's cost plus
+// heuristic estimate exceeds the current threshold. Starting paths added
+// before the first iteration are held back until the threshold is known.
+func (i *IDAStar) Add(newPath path.Pather) {
+	if newPath.Len() == 1 {
+		i.starts = append(i.starts, newPath)
+
+		if math.IsInf(i.threshold, -1) {
+			i.next = math.Min(i.next, i.estimate(newPath))
+			return
+		}
+	}
+
+	i.push(newPath)
+}
+
+// Next pops the path that is sitting on top of the stack. Once the stack has
+// been exhausted, if any paths were refused, the threshold is raised and the
+// search starts again from the starting paths.
+func (i *IDAStar) Next() path.Pather {
+	if i.stack.Len() == 0 && !math.IsInf(i.next, 1) {
+		i.previous = i.threshold
+		i.threshold = i.next
+		i.next = math.Inf(1)
+		for _, start := range i.starts {
+			i.push(start)
+		}
+	}
+
+	return i.stack.Next()
+}
+
+// Bound returns the threshold of the current iteration.
+func (i IDAStar) Bound() float64 {
+	return i.threshold
+}
+
+// Revisited returns true if the path's estimate fell within the previous
+// iteration's threshold, in which case, it was returned in an earlier
+// iteration.
+func (i IDAStar) Revisited(path path.Pather) bool {
+	return i.estimate(path) <= i.previous
+}
+
+// push pushes the path on to the stack if it falls within the threshold,
+// otherwise it notes the path's estimate as a candidate for the next
+// threshold.
+func (i *IDAStar) push(newPath path.Pather) {
+	estimate := i.estimate(newPath)
+	if estimate > i.threshold {
+		if estimate < i.next {
+			i.next = estimate
+		}
+
+		i.discarded++
+		return
+	}
+
+	i.stack.Add(newPath)
+	i.added++
+}
+
+// estimate returns the path's cost plus the heuristic estimate from the last
+// vertex on the path.
+func (i IDAStar) estimate(path path.Pather) float64 {
+	return path.Cost() + i.heuristic(path.Last().Head())
+}
+
+// Added returns the number of paths pushed on to the stack, including the
+// starting paths replayed by each iteration.
+func (i IDAStar) Added() int {
+	return i.added
+}
+
+// Discarded returns the number of paths refused for exceeding the threshold
+// of the iteration they were added in.
+func (i IDAStar) Discarded() int {
+	return i.discarded
+}
+
+var (
+	_ Deepener  = &IDAStar{}
+	_ Discarder = &IDAStar{}
+)
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/path"
+)
+
+// NewIterativeDeepeningSearch returns an iterative deepening depth-first search
+// strategy.
+func NewIterativeDeepeningSearch() *IDDFS {
+	return &IDDFS{
+		stack:  NewDepthFirstSearch(),
+		starts: []path.Pather{},
+		bound:  0,
+		cutoff: false,
+	}
+}
+
+// IDDFS provides an iterative deepening depth-first search strategy. It
+// performs a depth-first search that refuses paths deeper than a bound, and
+// once exhausted, increases the bound and starts again. Solutions are found in
+// order of the fewest edges travelled, as with a breadth-first search, but
+// only the current path's siblings and ancestors are stored.
+type IDDFS struct {
+	// stack provides the frontier for the current iteration.
+	stack *DFS
+	// starts contains the starting paths, which are replayed at the
+	// beginning of each iteration.
+	starts []path.Pather
+	// bound contains the number of edges, not including the starting edge, a
+	// path may travel in the current iteration.
+	bound int
+	// cutoff records whether any path has been refused in the current
+	// iteration, in which case, a deeper iteration may find more solutions.
+	cutoff bool
+	// added contains the number of paths pushed on to the stack.
+	added int
+	// discarded contains the number of paths refused for being too deep.
+	discarded int
+}
+
+// Len returns the current number of paths stored in the stack.
+func (i IDDFS) Len() int {
+	return i.stack.Len()
+}
+
+// Add pushes a path on to the top of the stack, unless the path travels
+// deeper than the current bound.
+func (i *IDDFS) Add(newPath path.Pather) {
+	depth := newPath.Len() - 1
+	if depth == 0 && i.bound == 0 {
+		i.starts = append(i.starts, newPath)
+	}
+
+	if depth > i.bound {
+		i.cutoff = true
+		i.discarded++
+		return
+	}
+
+	i.stack.Add(newPath)
+	i.added++
+}
+
+// Next pops the path that is sitting on top of the stack. Once the stack has
+// been exhausted, if any paths were refused, the bound is increased and the
+// search starts again from the starting paths.
+func (i *IDDFS) Next() path.Pather {
+	if i.stack.Len() == 0 && i.cutoff {
+		i.bound++
+		i.cutoff = false
+		for _, start := range i.starts {
+			i.stack.Add(start)
+			i.added++
+		}
+	}
+
+	return i.stack.Next()
+}
+
+// Bound returns the depth paths may reach in the current iteration.
+func (i IDDFS) Bound() float64 {
+	return float64(i.bound)
+}
+
+// Revisited returns true if the path is shallower than the current bound, in
+// which case, it was returned in an earlier iteration.
+func (i IDDFS) Revisited(path path.Pather) bool {
+	return path.Len()-1 < i.bound
+}
+
+// Added returns the number of paths pushed on to the stack, including the
+// starting paths replayed by each iteration.
+func (i IDDFS) Added() int {
+	return i.added
+}
+
+// Discarded returns the number of paths refused for travelling deeper than
+// the bound of the iteration they were added in.
+func (i IDDFS) Discarded() int {
+	return i.discarded
+}
+
+var (
+	_ Deepener  = &IDDFS{}
+	_ Discarder = &IDDFS{}
+)
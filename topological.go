@@ -0,0 +1,176 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Standard Library Imports
+	"errors"
+	"fmt"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/edge"
+	"github.com/matthewhartstonge/graph/path"
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+var (
+	// ErrUndirected is returned when an algorithm requiring a digraph is given
+	// a graph containing undirected edges.
+	ErrUndirected = errors.New("graph: graph is not a digraph")
+	// ErrCycle is returned when an algorithm requiring an acyclic graph is
+	// given a graph containing a cycle.
+	ErrCycle = errors.New("graph: graph contains a cycle")
+)
+
+// CycleError provides a cycle that stopped an algorithm requiring an acyclic
+// graph from completing.
+type CycleError struct {
+	// Cycle contains a path starting and ending at the same vertex.
+	Cycle path.Pather
+}
+
+// Error implements error.
+func (e CycleError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrCycle, e.Cycle)
+}
+
+// Unwrap enables errors.Is to match against ErrCycle.
+func (e CycleError) Unwrap() error {
+	return ErrCycle
+}
+
+// TopologicalSort returns the vertices of a digraph ordered such that the tail
+// of every edge comes before its head. For example, if each edge leads from a
+// task to a task depending on it, every task is ordered after the tasks it
+// depends on.
+//
+// ErrUndirected is returned if the graph contains undirected edges, and if the
+// graph contains a cycle, so has no such order, a CycleError is returned
+// containing the cycle.
+func TopologicalSort(g *Graph) ([]vertex.Vertexer, error) {
+	if !g.digraph {
+		return nil, ErrUndirected
+	}
+
+	order, cycle := g.depthFirstOrder()
+	if cycle != nil {
+		return nil, CycleError{Cycle: cycle}
+	}
+
+	return order, nil
+}
+
+// IsDAG returns true if the graph is a directed acyclic graph, that is, a
+// digraph containing no cycles.
+func (g *Graph) IsDAG() bool {
+	_, err := TopologicalSort(g)
+	return err == nil
+}
+
+// depthFirstOrder explores the graph depth-first, returning the vertices in
+// reverse order of when their exploration finished, which for an acyclic
+// digraph, is a topological order. If a cycle is found along the way, the
+// exploration stops and the cycle is returned instead.
+//
+// Undirected edges may be travelled in either direction, but never straight
+// back along the edge just travelled.
+func (g Graph) depthFirstOrder() (order []vertex.Vertexer, cycle path.Pather) {
+	session := NewSession()
+
+	// trail contains the edges travelled to reach the vertex being explored,
+	// from which a cycle can be dug out.
+	trail := []edge.Edger{}
+
+	var visit func(v vertex.Vertexer) path.Pather
+	visit = func(v vertex.Vertexer) path.Pather {
+		session.Discover(v)
+		for _, e := range g.adjacent[v] {
+			if len(trail) > 0 && sameEdge(e, trail[len(trail)-1]) {
+				continue
+			}
+
+			switch session.Colour(e.Head()) {
+			case White:
+				trail = append(trail, e)
+				if cycle := visit(e.Head()); cycle != nil {
+					return cycle
+				}
+				trail = trail[:len(trail)-1]
+
+			case Grey:
+				// We've found our way back to a vertex we're still exploring
+				// the descendants of, so have travelled around a cycle.
+				return cycleAlong(trail, e)
+			}
+		}
+
+		session.Finish(v)
+		order = append(order, v)
+		return nil
+	}
+
+	for _, v := range g.vertices() {
+		if session.Colour(v) != White {
+			continue
+		}
+
+		if cycle = visit(v); cycle != nil {
+			return nil, cycle
+		}
+	}
+
+	// Vertices finish after all of their descendants, so are ordered in
+	// reverse.
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	return order, nil
+}
+
+// sameEdge returns true if both edges are the same edge, even if one is
+// oriented in reverse.
+func sameEdge(a, b edge.Edger) bool {
+	return a == b || edge.Reverse(a) == b || edge.Reverse(b) == a
+}
+
+// cycleAlong returns the cycle formed by the closing edge leading back to a
+// vertex earlier along the trail of edges.
+func cycleAlong(trail []edge.Edger, closing edge.Edger) path.Pather {
+	start := closing.Head()
+
+	// Find where the trail left the vertex the closing edge leads back to,
+	// unless the closing edge loops straight back to where it came from.
+	from := len(trail)
+	if closing.Tail() != start {
+		for from > 0 {
+			from--
+			if trail[from].Tail() == start {
+				break
+			}
+		}
+	}
+
+	cycle := startPath(start)
+	for _, e := range trail[from:] {
+		cycle.Append(e)
+	}
+	cycle.Append(closing)
+
+	return cycle
+}
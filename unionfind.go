@@ -0,0 +1,78 @@
+/*
+ * Copyright (C) 2019. Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	// Internal Imports
+	"github.com/matthewhartstonge/graph/vertex"
+)
+
+// newUnionFind returns a union-find where each vertex starts in a set of its
+// own.
+func newUnionFind() *unionFind {
+	return &unionFind{
+		parent: map[vertex.Vertexer]vertex.Vertexer{},
+		rank:   map[vertex.Vertexer]int{},
+	}
+}
+
+// unionFind provides a disjoint-set forest, tracking which vertices have been
+// joined into the same set.
+type unionFind struct {
+	// parent contains the vertex each vertex was joined under. Vertices
+	// without a parent represent their set.
+	parent map[vertex.Vertexer]vertex.Vertexer
+	// rank contains an upper bound on the height of each representative's
+	// tree, so the shorter tree can be joined under the taller.
+	rank map[vertex.Vertexer]int
+}
+
+// find returns the vertex representing the set the vertex belongs to.
+func (u *unionFind) find(v vertex.Vertexer) vertex.Vertexer {
+	parent, ok := u.parent[v]
+	if !ok {
+		return v
+	}
+
+	// Point the vertex straight at its representative, so the next find is
+	// quicker.
+	root := u.find(parent)
+	u.parent[v] = root
+	return root
+}
+
+// union joins the sets the two vertices belong to, returning false if they
+// were already in the same set.
+func (u *unionFind) union(a, b vertex.Vertexer) bool {
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	switch {
+	case u.rank[rootA] < u.rank[rootB]:
+		u.parent[rootA] = rootB
+	case u.rank[rootA] > u.rank[rootB]:
+		u.parent[rootB] = rootA
+	default:
+		u.parent[rootB] = rootA
+		u.rank[rootA]++
+	}
+
+	return true
+}
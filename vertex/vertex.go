@@ -24,7 +24,15 @@ type Vertexer interface {
 	AddChild(vertexer Vertexer) Vertexer
 	Parents() []Vertexer
 	AddParent(vertexer Vertexer) Vertexer
+	// Visited returns true if the vertex has been marked as visited.
+	//
+	// Deprecated: visitation stored on a vertex is shared by every graph the
+	// vertex belongs to. Use a graph.Session to track visitation instead.
 	Visited() bool
+	// SetVisited marks the vertex as visited.
+	//
+	// Deprecated: visitation stored on a vertex is shared by every graph the
+	// vertex belongs to. Use a graph.Session to track visitation instead.
 	SetVisited(visited bool)
 }
 